@@ -0,0 +1,168 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+func generateAssetClient(f *jen.File) {
+	f.Comment("toAsset converts a raw contentful asset into an Asset")
+	f.Func().Id("toAsset").Params(
+		jen.Id("asset").Id("includeAsset"),
+	).Id("Asset").Block(
+		jen.Return(jen.Id("Asset").Values(jen.Dict{
+			jen.Id("ID"):          jen.Id("asset.Sys.ID"),
+			jen.Id("Title"):       jen.Id("asset.Fields.Title"),
+			jen.Id("Description"): jen.Id("asset.Fields.Description"),
+			jen.Id("URL"):         jen.Qual("fmt", "Sprintf").Call(jen.Lit("https:%s"), jen.Id("asset.Fields.File.URL")),
+			jen.Id("Width"):       jen.Id("asset.Fields.File.Details.Image.Width"),
+			jen.Id("Height"):      jen.Id("asset.Fields.File.Details.Image.Height"),
+			jen.Id("Size"):        jen.Id("asset.Fields.File.Details.Size"),
+			jen.Id("FileName"):    jen.Id("asset.Fields.File.FileName"),
+			jen.Id("ContentType"): jen.Id("asset.Fields.File.ContentType"),
+		})),
+	)
+
+	f.Comment("AssetListOptions contains pagination and filter configuration for asset iterators. Locale overrides the client locale if set")
+	f.Type().Id("AssetListOptions").Struct(
+		jen.Id("Page").Int(),
+		jen.Id("Limit").Int(),
+		jen.Id("MimetypeGroup").String(),
+		jen.Id("Locale").String(),
+	)
+
+	f.Comment("AssetIterator is used to paginate result sets of Asset")
+	f.Type().Id("AssetIterator").Struct(
+		append(
+			iteratorFields(),
+			jen.Id("MimetypeGroup").String(),
+			jen.Id("c").Op("*").Id("ContentClient"),
+			jen.Id("locale").String(),
+			jen.Id("items").Index().Op("*").Id("Asset"),
+		)...,
+	)
+
+	f.Comment("assetsResponse holds an entire contentful asset response")
+	f.Type().Id("assetsResponse").Struct(
+		jen.Id("Total").Int().Tag(map[string]string{"json": "total"}),
+		jen.Id("Skip").Int().Tag(map[string]string{"json": "skip"}),
+		jen.Id("Limit").Int().Tag(map[string]string{"json": "limit"}),
+		jen.Id("Items").Index().Id("includeAsset").Tag(map[string]string{"json": "items"}),
+	)
+
+	generateIteratorMethods(f, "AssetIterator", "Asset")
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id("AssetIterator"),
+	).Id("fetch").Params().Id("error").Block(
+		jen.Id("c").Op(":=").Id("it.c"),
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("it.locale")),
+		jen.Id("group").Op(":=").Qual("net/url", "QueryEscape").Call(jen.Id("it.MimetypeGroup")),
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/assets?access_token=%s&locale=%s&limit=%d&skip=%d&order=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+			jen.Id("it.Limit"),
+			jen.Id("it.Offset"),
+			jen.Id("defaultOrder"),
+		),
+		jen.If(jen.Id("it.MimetypeGroup").Op("!=").Lit("")).Block(
+			jen.Id("url").Op("+=").Lit("&mimetype_group=").Op("+").Id("group"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("assetsResponse"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Var().Id("items").Op("=").Make(jen.Index().Op("*").Id("Asset"), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
+			jen.Id("asset").Op(":=").Id("toAsset").Call(jen.Id("raw")),
+			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id("asset"),
+		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("Assets retrieves paginated Asset entries")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("Assets").Params(
+		jen.Id("opts").Id("AssetListOptions"),
+	).Op("*").Id("AssetIterator").Block(
+		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
+			jen.Id("opts.Limit").Op("=").Lit(100),
+		),
+		jen.If(jen.Id("opts.Locale").Op("==").Lit("")).Block(
+			jen.Id("opts.Locale").Op("=").Id("c.Locale"),
+		),
+
+		jen.Id("it").Op(":=").Op("&").Id("AssetIterator").Values(jen.Dict{
+			jen.Id("Limit"):         jen.Id("opts.Limit"),
+			jen.Id("Offset"):        jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("MimetypeGroup"): jen.Id("opts.MimetypeGroup"),
+			jen.Id("c"):             jen.Id("c"),
+			jen.Id("locale"):        jen.Id("opts.Locale"),
+		}),
+		jen.Return(jen.Id("it")),
+	)
+
+	f.Comment("AssetOptions contains configuration for fetching a single Asset. Locale overrides the client locale if set")
+	f.Type().Id("AssetOptions").Struct(
+		jen.Id("Locale").String(),
+	)
+
+	f.Comment("Asset retrieves a single Asset by its ID, in the locale of opts if given")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("Asset").Params(
+		jen.Id("id").String(),
+		jen.Id("opts").Op("...").Id("AssetOptions"),
+	).Params(
+		jen.Op("*").Id("Asset"), jen.Id("error"),
+	).Block(
+		jen.Id("locale").Op(":=").Id("c.Locale"),
+		jen.If(jen.Len(jen.Id("opts")).Op(">").Lit(0).Op("&&").Id("opts").Index(jen.Lit(0)).Dot("Locale").Op("!=").Lit("")).Block(
+			jen.Id("locale").Op("=").Id("opts").Index(jen.Lit(0)).Dot("Locale"),
+		),
+		jen.Id("path").Op(":=").Qual("net/url", "PathEscape").Call(jen.Id("id")),
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("locale")),
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/assets/%s?access_token=%s&locale=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("path"),
+			jen.Id("c.authToken"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("==").Qual("net/http", "StatusNotFound")).Block(
+			jen.Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"): jen.Id("id"),
+			})),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("raw").Id("includeAsset"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("raw")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("asset").Op(":=").Id("toAsset").Call(jen.Id("raw")),
+		jen.Return(jen.Op("&").Id("asset"), jen.Nil()),
+	)
+}
@@ -0,0 +1,450 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+func generateCache(f *jen.File) {
+	f.Comment("Cache stores entries and assets across requests of a client. Keys are prefixed with the link type, e.g. Entry:<id> or Asset:<id>. Implementations must be safe for concurrent use.")
+	f.Comment("Values are the raw entries and assets of the delivery api encoded as JSON []byte, so any backing store can hold them. Get may return them as []byte or string. Entries are resolved again on every lookup")
+	f.Type().Id("Cache").Interface(
+		jen.Id("Get").Params(jen.Id("key").String()).Params(jen.Interface(), jen.Bool()),
+		jen.Id("Set").Params(jen.Id("key").String(), jen.Id("value").Interface()),
+		jen.Id("Delete").Params(jen.Id("key").String()),
+	)
+
+	f.Comment("lruCache is a size bounded Cache evicting the least recently used values and values older than its ttl")
+	f.Type().Id("lruCache").Struct(
+		jen.Id("mu").Qual("sync", "Mutex"),
+		jen.Id("size").Int(),
+		jen.Id("ttl").Qual("time", "Duration"),
+		jen.Id("items").Map(jen.String()).Op("*").Qual("container/list", "Element"),
+		jen.Id("order").Op("*").Qual("container/list", "List"),
+		jen.Id("onEvict").Func().Params(jen.Id("key").String()),
+	)
+
+	f.Type().Id("lruItem").Struct(
+		jen.Id("key").String(),
+		jen.Id("value").Interface(),
+		jen.Id("expires").Qual("time", "Time"),
+	)
+
+	f.Comment("NewLRUCache returns a Cache holding up to size values for at most ttl. Zero or less disables the respective limit")
+	f.Func().Id("NewLRUCache").Params(
+		jen.Id("size").Int(),
+		jen.Id("ttl").Qual("time", "Duration"),
+	).Id("Cache").Block(
+		jen.Return(jen.Op("&").Id("lruCache").Values(jen.Dict{
+			jen.Id("size"):  jen.Id("size"),
+			jen.Id("ttl"):   jen.Id("ttl"),
+			jen.Id("items"): jen.Map(jen.String()).Op("*").Qual("container/list", "Element").Values(),
+			jen.Id("order"): jen.Qual("container/list", "New").Call(),
+		})),
+	)
+
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("Get").Params(
+		jen.Id("key").String(),
+	).Params(jen.Interface(), jen.Bool()).Block(
+		jen.Id("c.mu").Dot("Lock").Call(),
+		jen.List(jen.Id("el"), jen.Id("ok")).Op(":=").Id("c.items").Index(jen.Id("key")),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Id("c.mu").Dot("Unlock").Call(),
+			jen.Return(jen.Nil(), jen.False()),
+		),
+		jen.Id("item").Op(":=").Id("el.Value").Assert(jen.Op("*").Id("lruItem")),
+		jen.If(jen.Id("c").Dot("expired").Call(jen.Id("item"))).Block(
+			jen.Id("c").Dot("remove").Call(jen.Id("el")),
+			jen.Id("c.mu").Dot("Unlock").Call(),
+			jen.Id("c").Dot("notify").Call(jen.Index().String().Values(jen.Id("key"))),
+			jen.Return(jen.Nil(), jen.False()),
+		),
+		jen.Id("c.order").Dot("MoveToFront").Call(jen.Id("el")),
+		jen.Id("c.mu").Dot("Unlock").Call(),
+		jen.Return(jen.Id("item.value"), jen.True()),
+	)
+
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("Set").Params(
+		jen.Id("key").String(),
+		jen.Id("value").Interface(),
+	).Block(
+		jen.Id("c.mu").Dot("Lock").Call(),
+		jen.Id("item").Op(":=").Op("&").Id("lruItem").Values(jen.Dict{
+			jen.Id("key"):     jen.Id("key"),
+			jen.Id("value"):   jen.Id("value"),
+			jen.Id("expires"): jen.Qual("time", "Now").Call().Dot("Add").Call(jen.Id("c.ttl")),
+		}),
+		jen.If(
+			jen.List(jen.Id("el"), jen.Id("ok")).Op(":=").Id("c.items").Index(jen.Id("key")),
+			jen.Id("ok"),
+		).Block(
+			jen.Id("el.Value").Op("=").Id("item"),
+			jen.Id("c.order").Dot("MoveToFront").Call(jen.Id("el")),
+			jen.Id("c.mu").Dot("Unlock").Call(),
+			jen.Return(),
+		),
+		jen.Id("c.items").Index(jen.Id("key")).Op("=").Id("c.order").Dot("PushFront").Call(jen.Id("item")),
+		jen.Var().Id("evicted").Index().String(),
+		jen.Comment("drop expired values which were not requested since, the back of the list holds the least recently used values"),
+		jen.For(jen.Id("oldest").Op(":=").Id("c.order").Dot("Back").Call(), jen.Id("oldest").Op("!=").Nil(), jen.Id("oldest").Op("=").Id("c.order").Dot("Back").Call()).Block(
+			jen.Id("old").Op(":=").Id("oldest.Value").Assert(jen.Op("*").Id("lruItem")),
+			jen.If(jen.Op("!").Id("c").Dot("expired").Call(jen.Id("old")).Op("&&").Parens(jen.Id("c.size").Op("<=").Lit(0).Op("||").Id("c.order").Dot("Len").Call().Op("<=").Id("c.size"))).Block(
+				jen.Break(),
+			),
+			jen.Id("c").Dot("remove").Call(jen.Id("oldest")),
+			jen.Id("evicted").Op("=").Append(jen.Id("evicted"), jen.Id("old.key")),
+		),
+		jen.Id("c.mu").Dot("Unlock").Call(),
+		jen.Id("c").Dot("notify").Call(jen.Id("evicted")),
+	)
+
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("expired").Params(
+		jen.Id("item").Op("*").Id("lruItem"),
+	).Bool().Block(
+		jen.Return(jen.Id("c.ttl").Op(">").Lit(0).Op("&&").Qual("time", "Now").Call().Dot("After").Call(jen.Id("item.expires"))),
+	)
+
+	f.Comment("remove drops an element. The caller must hold the lock")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("remove").Params(
+		jen.Id("el").Op("*").Qual("container/list", "Element"),
+	).Block(
+		jen.Id("c.order").Dot("Remove").Call(jen.Id("el")),
+		jen.Delete(jen.Id("c.items"), jen.Id("el.Value").Assert(jen.Op("*").Id("lruItem")).Dot("key")),
+	)
+
+	f.Comment("notify reports values dropped because of the size limit or their ttl. It must be called without holding the lock")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("notify").Params(
+		jen.Id("keys").Index().String(),
+	).Block(
+		jen.If(jen.Id("c.onEvict").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("key")).Op(":=").Range().Id("keys")).Block(
+			jen.Id("c").Dot("onEvict").Call(jen.Id("key")),
+		),
+	)
+
+	f.Comment("setOnEvict registers fn to be called for every value dropped because of the size limit or its ttl")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("setOnEvict").Params(
+		jen.Id("fn").Func().Params(jen.Id("key").String()),
+	).Block(
+		jen.Id("c.mu").Dot("Lock").Call(),
+		jen.Id("c.onEvict").Op("=").Id("fn"),
+		jen.Id("c.mu").Dot("Unlock").Call(),
+	)
+
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("lruCache"),
+	).Id("Delete").Params(
+		jen.Id("key").String(),
+	).Block(
+		jen.Id("c.mu").Dot("Lock").Call(),
+		jen.Defer().Id("c.mu").Dot("Unlock").Call(),
+		jen.If(
+			jen.List(jen.Id("el"), jen.Id("ok")).Op(":=").Id("c.items").Index(jen.Id("key")),
+			jen.Id("ok"),
+		).Block(
+			jen.Id("c").Dot("remove").Call(jen.Id("el")),
+		),
+	)
+
+	f.Comment("evictionNotifier is implemented by caches reporting values they drop on their own")
+	f.Type().Id("evictionNotifier").Interface(
+		jen.Id("setOnEvict").Params(jen.Id("fn").Func().Params(jen.Id("key").String())),
+	)
+
+	f.Comment("sharedCache wraps the client cache and tracks which cached entries link to other entries and assets, so changes can be evicted along with everything resolving through them.")
+	f.Comment("links holds the targets of every tracked entry and asset, typeOf the content type of every tracked entry, to remove them from the reverse indexes once they leave the cache")
+	f.Type().Id("sharedCache").Struct(
+		jen.Id("cache").Id("Cache"),
+		jen.Id("mu").Qual("sync", "Mutex"),
+		jen.Id("linkedBy").Map(jen.String()).Map(jen.String()).Bool(),
+		jen.Id("types").Map(jen.String()).Map(jen.String()).Bool(),
+		jen.Id("links").Map(jen.String()).Index().String(),
+		jen.Id("typeOf").Map(jen.String()).String(),
+	)
+
+	f.Comment("newSharedCache wraps cache, evicting the entries linking to values cache drops on its own")
+	f.Func().Id("newSharedCache").Params(
+		jen.Id("cache").Id("Cache"),
+	).Op("*").Id("sharedCache").Block(
+		jen.Id("s").Op(":=").Op("&").Id("sharedCache").Values(jen.Dict{
+			jen.Id("cache"):    jen.Id("cache"),
+			jen.Id("linkedBy"): jen.Map(jen.String()).Map(jen.String()).Bool().Values(),
+			jen.Id("types"):    jen.Map(jen.String()).Map(jen.String()).Bool().Values(),
+			jen.Id("links"):    jen.Map(jen.String()).Index().String().Values(),
+			jen.Id("typeOf"):   jen.Map(jen.String()).String().Values(),
+		}),
+		jen.If(
+			jen.List(jen.Id("n"), jen.Id("ok")).Op(":=").Id("cache").Assert(jen.Id("evictionNotifier")),
+			jen.Id("ok"),
+		).Block(
+			jen.Id("n").Dot("setOnEvict").Call(jen.Id("s.forget")),
+		),
+		jen.Return(jen.Id("s")),
+	)
+
+	f.Comment("forget handles a key which left the cache. Entries linking to it would resolve without it, so they are evicted as well")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("forget").Params(
+		jen.Id("key").String(),
+	).Block(
+		jen.Id("s").Dot("evict").Call(jen.Id("key")),
+	)
+
+	f.Comment("untrack removes the links and the content type of key from the reverse indexes. The caller must hold the lock")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("untrack").Params(
+		jen.Id("key").String(),
+	).Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("target")).Op(":=").Range().Id("s.links").Index(jen.Id("key"))).Block(
+			jen.Delete(jen.Id("s.linkedBy").Index(jen.Id("target")), jen.Id("key")),
+			jen.If(jen.Len(jen.Id("s.linkedBy").Index(jen.Id("target"))).Op("==").Lit(0)).Block(
+				jen.Delete(jen.Id("s.linkedBy"), jen.Id("target")),
+			),
+		),
+		jen.Delete(jen.Id("s.links"), jen.Id("key")),
+		jen.If(
+			jen.List(jen.Id("contentType"), jen.Id("ok")).Op(":=").Id("s.typeOf").Index(jen.Id("key")),
+			jen.Id("ok"),
+		).Block(
+			jen.Delete(jen.Id("s.types").Index(jen.Id("contentType")), jen.Id("key")),
+			jen.If(jen.Len(jen.Id("s.types").Index(jen.Id("contentType"))).Op("==").Lit(0)).Block(
+				jen.Delete(jen.Id("s.types"), jen.Id("contentType")),
+			),
+			jen.Delete(jen.Id("s.typeOf"), jen.Id("key")),
+		),
+	)
+
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("get").Params(
+		jen.Id("key").String(),
+	).Params(jen.Interface(), jen.Bool()).Block(
+		jen.If(jen.Id("s").Op("==").Nil()).Block(
+			jen.Return(jen.Nil(), jen.False()),
+		),
+		jen.Return(jen.Id("s.cache").Dot("Get").Call(jen.Id("key"))),
+	)
+
+	f.Comment("entry returns the cached raw entry with the given ID")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("entry").Params(
+		jen.Id("id").String(),
+	).Params(jen.Op("*").Id("includeEntry"), jen.Bool()).Block(
+		jen.Var().Id("entry").Id("includeEntry"),
+		jen.If(
+			jen.Op("!").Id("s").Dot("decode").Call(jen.Lit("Entry:").Op("+").Id("id"), jen.Op("&").Id("entry")),
+		).Block(
+			jen.Return(jen.Nil(), jen.False()),
+		),
+		jen.Return(jen.Op("&").Id("entry"), jen.True()),
+	)
+
+	f.Comment("asset returns the cached raw asset with the given ID")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("asset").Params(
+		jen.Id("id").String(),
+	).Params(jen.Op("*").Id("includeAsset"), jen.Bool()).Block(
+		jen.Var().Id("asset").Id("includeAsset"),
+		jen.If(
+			jen.Op("!").Id("s").Dot("decode").Call(jen.Lit("Asset:").Op("+").Id("id"), jen.Op("&").Id("asset")),
+		).Block(
+			jen.Return(jen.Nil(), jen.False()),
+		),
+		jen.Return(jen.Op("&").Id("asset"), jen.True()),
+	)
+
+	f.Comment("decode unmarshals the value cached for key into v")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("decode").Params(
+		jen.Id("key").String(),
+		jen.Id("v").Interface(),
+	).Bool().Block(
+		jen.List(jen.Id("value"), jen.Id("ok")).Op(":=").Id("s").Dot("get").Call(jen.Id("key")),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Return(jen.False()),
+		),
+		jen.Var().Id("raw").Index().Byte(),
+		jen.Switch(jen.Id("value").Op(":=").Id("value").Assert(jen.Type())).Block(
+			jen.Case(jen.Index().Byte()).Block(
+				jen.Id("raw").Op("=").Id("value"),
+			),
+			jen.Case(jen.String()).Block(
+				jen.Id("raw").Op("=").Index().Byte().Call(jen.Id("value")),
+			),
+			jen.Default().Block(
+				jen.Return(jen.False()),
+			),
+		),
+		jen.Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("raw"), jen.Id("v")).Op("==").Nil()),
+	)
+
+	f.Comment("setEntry caches a raw entry and records its links")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("setEntry").Params(
+		jen.Id("entry").Op("*").Id("includeEntry"),
+	).Block(
+		jen.If(jen.Id("s").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Id("key").Op(":=").Lit("Entry:").Op("+").Id("entry.Sys.ID"),
+		jen.Id("links").Op(":=").Op("&").Id("linkSet").Values(jen.Dict{
+			jen.Id("entries"): jen.Map(jen.String()).Index().String().Values(),
+		}),
+		jen.If(
+			jen.Err().Op(":=").Id("entryLinks").Call(jen.Id("entry"), jen.Id("links")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(),
+		),
+		jen.List(jen.Id("value"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("entry")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Var().Id("targets").Index().String(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("ids")).Op(":=").Range().Id("links.entries")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+				jen.Id("targets").Op("=").Append(jen.Id("targets"), jen.Lit("Entry:").Op("+").Id("id")),
+			),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("links.assets")).Block(
+			jen.Id("targets").Op("=").Append(jen.Id("targets"), jen.Lit("Asset:").Op("+").Id("id")),
+		),
+		jen.Id("add").Op(":=").Func().Params(
+			jen.Id("m").Map(jen.String()).Map(jen.String()).Bool(),
+			jen.List(jen.Id("target"), jen.Id("key")).String(),
+		).Block(
+			jen.If(jen.Id("m").Index(jen.Id("target")).Op("==").Nil()).Block(
+				jen.Id("m").Index(jen.Id("target")).Op("=").Map(jen.String()).Bool().Values(),
+			),
+			jen.Id("m").Index(jen.Id("target")).Index(jen.Id("key")).Op("=").True(),
+		),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Id("s").Dot("untrack").Call(jen.Id("key")),
+		jen.For(jen.List(jen.Id("_"), jen.Id("target")).Op(":=").Range().Id("targets")).Block(
+			jen.Id("add").Call(jen.Id("s.linkedBy"), jen.Id("target"), jen.Id("key")),
+		),
+		jen.Id("s.links").Index(jen.Id("key")).Op("=").Id("targets"),
+		jen.Id("add").Call(jen.Id("s.types"), jen.Id("entry.Sys.ContentType.Sys.ID"), jen.Id("key")),
+		jen.Id("s.typeOf").Index(jen.Id("key")).Op("=").Id("entry.Sys.ContentType.Sys.ID"),
+		jen.Id("s.mu").Dot("Unlock").Call(),
+		jen.Id("s").Dot("store").Call(jen.Id("key"), jen.Id("value")),
+	)
+
+	f.Comment("setAsset caches a raw asset")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("setAsset").Params(
+		jen.Id("asset").Op("*").Id("includeAsset"),
+	).Block(
+		jen.If(jen.Id("s").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Id("key").Op(":=").Lit("Asset:").Op("+").Id("asset.Sys.ID"),
+		jen.List(jen.Id("value"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("asset")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Id("s.links").Index(jen.Id("key")).Op("=").Nil(),
+		jen.Id("s.mu").Dot("Unlock").Call(),
+		jen.Id("s").Dot("store").Call(jen.Id("key"), jen.Id("value")),
+	)
+
+	f.Comment("store sets the value of a tracked key. The cache may report evictions from within Set, so it is called without holding the lock.")
+	f.Comment("A concurrent evict between tracking key and storing its value would leave the value cached but untracked, out of reach of later invalidations, so such values are deleted again")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("store").Params(
+		jen.Id("key").String(),
+		jen.Id("value").Index().Byte(),
+	).Block(
+		jen.Id("s.cache").Dot("Set").Call(jen.Id("key"), jen.Id("value")),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Defer().Id("s.mu").Dot("Unlock").Call(),
+		jen.If(
+			jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("s.links").Index(jen.Id("key")),
+			jen.Op("!").Id("ok"),
+		).Block(
+			jen.Id("s.cache").Dot("Delete").Call(jen.Id("key")),
+		),
+	)
+
+	f.Comment("evict removes the given keys and, transitively, all cached entries linking to them")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("evict").Params(
+		jen.Id("keys").Op("...").String(),
+	).Block(
+		jen.If(jen.Id("s").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Defer().Id("s.mu").Dot("Unlock").Call(),
+		jen.For(jen.Len(jen.Id("keys")).Op(">").Lit(0)).Block(
+			jen.Id("key").Op(":=").Id("keys").Index(jen.Len(jen.Id("keys")).Op("-").Lit(1)),
+			jen.Id("keys").Op("=").Id("keys").Index(jen.Empty(), jen.Len(jen.Id("keys")).Op("-").Lit(1)),
+			jen.Id("s.cache").Dot("Delete").Call(jen.Id("key")),
+			jen.Id("s").Dot("untrack").Call(jen.Id("key")),
+			jen.For(jen.Id("linker").Op(":=").Range().Id("s.linkedBy").Index(jen.Id("key"))).Block(
+				jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("linker")),
+			),
+			jen.Delete(jen.Id("s.linkedBy"), jen.Id("key")),
+		),
+	)
+
+	f.Comment("evictContentType removes all cached entries of a content type and the entries linking to them")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("sharedCache"),
+	).Id("evictContentType").Params(
+		jen.Id("contentType").String(),
+	).Block(
+		jen.If(jen.Id("s").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Var().Id("keys").Index().String(),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.For(jen.Id("key").Op(":=").Range().Id("s.types").Index(jen.Id("contentType"))).Block(
+			jen.Id("keys").Op("=").Append(jen.Id("keys"), jen.Id("key")),
+		),
+		jen.Delete(jen.Id("s.types"), jen.Id("contentType")),
+		jen.Id("s.mu").Dot("Unlock").Call(),
+		jen.Id("s").Dot("evict").Call(jen.Id("keys...")),
+	)
+
+	f.Comment("SetCache shares entries and assets between all requests of the client. A nil cache disables sharing")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("SetCache").Params(
+		jen.Id("cache").Id("Cache"),
+	).Block(
+		jen.If(jen.Id("cache").Op("==").Nil()).Block(
+			jen.Id("c.cache").Op("=").Nil(),
+			jen.Return(),
+		),
+		jen.Id("c.cache").Op("=").Id("newSharedCache").Call(jen.Id("cache")),
+	)
+
+	f.Comment("newCache returns an iterator cache for the client locale backed by the client cache")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("newCache").Params().Op("*").Id("iteratorCache").Block(
+		jen.Return(jen.Id("c").Dot("newLocaleCache").Call(jen.Id("c.Locale"))),
+	)
+}
@@ -10,7 +10,7 @@ import (
 func fetchCerts() (string, error) {
 	out := bytes.Buffer{}
 
-	endpoints := []string{cdaEndpoint, cpaEndpoint, cmaEndpoint}
+	endpoints := []string{cdaEndpoint, cpaEndpoint, cmaEndpoint, uploadEndpoint}
 	for _, endpoint := range endpoints {
 		conn, err := tls.Dial("tcp", endpoint+":443", &tls.Config{})
 		if err != nil {
@@ -8,75 +8,234 @@ import (
 )
 
 func generateContentClient(f *jen.File) {
+	f.Comment("linkIndex maps the IDs of all entries and assets of a single response for constant time link resolution")
+	f.Type().Id("linkIndex").Struct(
+		jen.Id("entries").Map(jen.String()).Op("*").Id("includeEntry"),
+		jen.Id("assets").Map(jen.String()).Op("*").Id("includeAsset"),
+	)
+
+	f.Comment("newLinkIndex indexes the items and includes of a response once so links don't require scans")
+	f.Func().Id("newLinkIndex").Params(
+		jen.Id("items").Index().Id("includeEntry"),
+		jen.Id("includes").Id("includes"),
+	).Op("*").Id("linkIndex").Block(
+		jen.Id("index").Op(":=").Op("&").Id("linkIndex").Values(jen.Dict{
+			jen.Id("entries"): jen.Make(jen.Map(jen.String()).Op("*").Id("includeEntry"), jen.Len(jen.Id("items")).Op("+").Len(jen.Id("includes.Entries"))),
+			jen.Id("assets"):  jen.Make(jen.Map(jen.String()).Op("*").Id("includeAsset"), jen.Len(jen.Id("includes.Assets"))),
+		}),
+		jen.For(jen.Id("i").Op(":=").Range().Id("items")).Block(
+			jen.Id("index.entries").Index(jen.Id("items").Index(jen.Id("i")).Dot("Sys.ID")).Op("=").Op("&").Id("items").Index(jen.Id("i")),
+		),
+		jen.For(jen.Id("i").Op(":=").Range().Id("includes.Entries")).Block(
+			jen.Id("index.entries").Index(jen.Id("includes.Entries").Index(jen.Id("i")).Dot("Sys.ID")).Op("=").Op("&").Id("includes.Entries").Index(jen.Id("i")),
+		),
+		jen.For(jen.Id("i").Op(":=").Range().Id("includes.Assets")).Block(
+			jen.Id("index.assets").Index(jen.Id("includes.Assets").Index(jen.Id("i")).Dot("Sys.ID")).Op("=").Op("&").Id("includes.Assets").Index(jen.Id("i")),
+		),
+		jen.Return(jen.Id("index")),
+	)
+
 	f.Func().Id("resolveAsset").Params(
 		jen.Id("assetID").String(),
-		jen.Id("includes").Id("includes"),
-	).Id("Asset").Block(
-		jen.For(
-			jen.List(jen.Id("_"), jen.Id("asset")).Op(":=").Range().Id("includes.Assets"),
+		jen.Id("index").Op("*").Id("linkIndex"),
+		jen.Id("cache").Id("*iteratorCache"),
+	).Id("Ref").Types(jen.Id("Asset")).Block(
+		jen.Id("ref").Op(":=").Id("Ref").Types(jen.Id("Asset")).Values(jen.Dict{jen.Id("ID"): jen.Id("assetID")}),
+		jen.If(
+			jen.List(jen.Id("asset"), jen.Id("ok")).Op(":=").Id("cache.shared").Dot("asset").Call(jen.Id("assetID")),
+			jen.Id("ok"),
 		).Block(
-			jen.If(jen.Id("asset.Sys.ID").Op("==").Id("assetID")).Block(
-				jen.Return(jen.Id("Asset").Values(jen.Dict{
-					jen.Id("URL"):    jen.Qual("fmt", "Sprintf").Call(jen.Lit("https:%s"), jen.Id("asset.Fields.File.URL")),
-					jen.Id("Width"):  jen.Id("asset.Fields.File.Details.Image.Width"),
-					jen.Id("Height"): jen.Id("asset.Fields.File.Details.Image.Height"),
-					jen.Id("Size"):   jen.Lit(0),
-				})),
-			),
+			jen.List(jen.Id("ref.Value"), jen.Id("ref.Resolved")).Op("=").List(jen.Id("toAsset").Call(jen.Op("*").Id("asset")), jen.True()),
+			jen.Return(jen.Id("ref")),
 		),
-		jen.Return(jen.Id("Asset").Values()),
+		jen.If(
+			jen.List(jen.Id("asset"), jen.Id("ok")).Op(":=").Id("index.assets").Index(jen.Id("assetID")),
+			jen.Id("ok"),
+		).Block(
+			jen.Id("ref.Value").Op("=").Id("toAsset").Call(jen.Op("*").Id("asset")),
+			jen.Id("ref.Resolved").Op("=").True(),
+			jen.Id("cache.shared").Dot("setAsset").Call(jen.Id("asset")),
+		),
+		jen.Return(jen.Id("ref")),
 	)
 
+	f.Comment("resolveEntries resolves multi type links in field order, keeping repeated references")
 	f.Func().Id("resolveEntries").Params(
 		jen.Id("ids").Id("entryIDs"),
-		jen.Id("its").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Index().Interface().Block(
-		jen.Var().Id("items").Index().Interface(),
-
-		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("includes.Entries")).Block(
-			jen.Var().Id("included").Op("=").Lit(false),
-			jen.For(jen.List(jen.Id("_"), jen.Id("entryID")).Op(":=").Range().Id("ids")).Block(
-				jen.Id("included").Op("=").Id("included").Op("||").Id("entryID.Sys.ID").Op("==").Id("entry.Sys.ID"),
-			),
-			jen.If(jen.Id("included").Op("==").Lit(true)).BlockFunc(func(g *jen.Group) {
-				for _, m := range models {
-					g.If(jen.Id("entry.Sys.ContentType.Sys.ID").Op("==").Lit(m.Sys.ID)).Block(
-						jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
-							jen.Id("entry.Sys.ID"),
-							jen.Id("its"),
-							jen.Id("includes"),
-							jen.Id("cache"),
-						)),
-					)
-				}
-			}),
+	).Index().Id("Ref").Types(jen.Id("Entry")).Block(
+		jen.Var().Id("refs").Index().Id("Ref").Types(jen.Id("Entry")),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+			jen.Id("refs").Op("=").Append(jen.Id("refs"), jen.Id("resolveEntry").Call(jen.Id("id"), jen.Id("index"), jen.Id("cache"))),
 		),
-		jen.Return(jen.Id("items")),
+		jen.Return(jen.Id("refs")),
 	)
 
 	f.Func().Id("resolveEntry").Params(
 		jen.Id("id").Id("entryID"),
-		jen.Id("its").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Interface().Block(
-		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("includes.Entries")).Block(
-			jen.If(jen.Id("entry.Sys.ID").Op("==").Id("id.Sys.ID")).BlockFunc(func(g *jen.Group) {
-				for _, m := range models {
-					g.If(jen.Id("entry.Sys.ContentType.Sys.ID").Op("==").Lit(m.Sys.ID)).Block(
-						jen.Return(jen.Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
-							jen.Id("entry.Sys.ID"),
-							jen.Id("its"),
-							jen.Id("includes"),
-							jen.Id("cache"),
-						)),
-					)
-				}
-			}),
+	).Id("Ref").Types(jen.Id("Entry")).Block(
+		jen.Id("ref").Op(":=").Id("Ref").Types(jen.Id("Entry")).Values(jen.Dict{jen.Id("ID"): jen.Id("id.Sys.ID")}),
+		jen.List(jen.Id("entry"), jen.Id("ok")).Op(":=").Id("index.entries").Index(jen.Id("id.Sys.ID")),
+		jen.If(jen.Op("!").Id("ok")).BlockFunc(func(g *jen.Group) {
+			g.Comment("entries resolved for earlier responses are only known to the caches")
+			for _, m := range models {
+				g.If(
+					jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(jen.Id("id.Sys.ID"), jen.Id("index"), jen.Id("cache")),
+					jen.Id("ok"),
+				).Block(
+					jen.List(jen.Id("ref.Value"), jen.Id("ref.Resolved")).Op("=").List(jen.Id("v"), jen.True()),
+					jen.Return(jen.Id("ref")),
+				)
+			}
+			g.Return(jen.Id("ref"))
+		}),
+		jen.Switch(jen.Id("entry.Sys.ContentType.Sys.ID")).BlockFunc(func(g *jen.Group) {
+			for _, m := range models {
+				g.Case(jen.Lit(m.Sys.ID)).Block(
+					jen.List(jen.Id("ref.Value"), jen.Id("ref.Resolved")).Op("=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
+						jen.Id("entry.Sys.ID"),
+						jen.Id("index"),
+						jen.Id("cache"),
+					),
+				)
+			}
+		}),
+		jen.Return(jen.Id("ref")),
+	)
+
+	f.Comment("maxIncludeDepth is the deepest link resolution contentful supports")
+	f.Const().Id("maxIncludeDepth").Op("=").Lit(10)
+
+	f.Comment("ErrResponseTooBig is returned when contentful rejects a response exceeding its size limit")
+	f.Var().Id("ErrResponseTooBig").Op("=").Qual("errors", "New").Call(jen.Lit("response size too big"))
+
+	f.Comment("ErrVersionMismatch is returned when a management api update is based on an outdated version of a resource")
+	f.Var().Id("ErrVersionMismatch").Op("=").Qual("errors", "New").Call(jen.Lit("version mismatch"))
+
+	f.Comment("responseError converts an unsuccessful response into an error")
+	f.Func().Id("responseError").Params(
+		jen.Id("resp").Op("*").Qual("net/http", "Response"),
+	).Error().Block(
+		jen.List(jen.Id("body"), jen.Id("_")).Op(":=").Qual("io", "ReadAll").Call(jen.Id("resp.Body")),
+		jen.Id("resp.Body.Close").Call(),
+		jen.If(
+			jen.Id("resp.StatusCode").Op("==").Qual("net/http", "StatusBadRequest").Op("&&").
+				Qual("bytes", "Contains").Call(jen.Id("body"), jen.Index().Byte().Parens(jen.Lit("Response size too big"))),
+		).Block(
+			jen.Return(jen.Id("ErrResponseTooBig")),
+		),
+		jen.If(
+			jen.Id("resp.StatusCode").Op("==").Qual("net/http", "StatusConflict").Op("&&").
+				Qual("bytes", "Contains").Call(jen.Id("body"), jen.Index().Byte().Parens(jen.Lit("VersionMismatch"))),
+		).Block(
+			jen.Return(jen.Id("ErrVersionMismatch")),
 		),
-		jen.Return(jen.Nil()),
+		jen.Return(jen.Qual("fmt", "Errorf").Call(
+			jen.Lit("Request failed: %s, %s"),
+			jen.Id("resp.Status"),
+			jen.Id("body"),
+		)),
+	)
+
+	f.Comment("NotFoundError is returned when a requested entry or asset does not exist. Field is set for lookups by a unique field other than the ID")
+	f.Type().Id("NotFoundError").Struct(
+		jen.Id("ID").String(),
+		jen.Id("Field").String(),
+		jen.Id("ContentType").String(),
+	)
+
+	f.Func().Params(
+		jen.Id("e").Op("*").Id("NotFoundError"),
+	).Id("Error").Params().String().Block(
+		jen.If(jen.Id("e.Field").Op("!=").Lit("")).Block(
+			jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s %s of type %s not found"), jen.Id("e.Field"), jen.Id("e.ID"), jen.Id("e.ContentType"))),
+		),
+		jen.If(jen.Id("e.ContentType").Op("==").Lit("")).Block(
+			jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s not found"), jen.Id("e.ID"))),
+		),
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s of type %s not found"), jen.Id("e.ID"), jen.Id("e.ContentType"))),
+	)
+
+	f.Comment("DuplicateError is returned by lookups by a unique field when more than one entry has the value. Type and Field are the generated Go names")
+	f.Type().Id("DuplicateError").Struct(
+		jen.Id("Type").String(),
+		jen.Id("Field").String(),
+		jen.Id("Value").String(),
+	)
+
+	f.Func().Params(
+		jen.Id("e").Op("*").Id("DuplicateError"),
+	).Id("Error").Params().String().Block(
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s.%s %q is not unique"), jen.Id("e.Type"), jen.Id("e.Field"), jen.Id("e.Value"))),
+	)
+
+	f.Comment("Entry retrieves a single entry of any content type by its ID. The result is a pointer to the matching generated type")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("Entry").Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Id("Entry"), jen.Id("error"),
+	).Block(
+		jen.Id("query").Op(":=").Qual("net/url", "QueryEscape").Call(jen.Id("id")),
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("c.Locale")),
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/entries?access_token=%s&locale=%s&include=%d&limit=1&sys.id=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+			jen.Id("maxIncludeDepth"),
+			jen.Id("query"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("entriesResponse"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Len(jen.Id("data.Items")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"): jen.Id("id"),
+			})),
+		),
+		jen.Id("cache").Op(":=").Id("c").Dot("newCache").Call(),
+		jen.Id("index").Op(":=").Id("newLinkIndex").Call(jen.Id("data.Items"), jen.Id("data.Includes")),
+		jen.If(jen.Id("c.fetchLinks")).Block(
+			jen.If(
+				jen.Err().Op(":=").Id("c.completeLinks").Call(jen.Id("index"), jen.Id("cache")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+		),
+		jen.Switch(jen.Id("data.Items").Index(jen.Lit(0)).Dot("Sys.ContentType.Sys.ID")).BlockFunc(func(g *jen.Group) {
+			for _, m := range models {
+				g.Case(jen.Lit(m.Sys.ID)).Block(
+					jen.List(jen.Id("entry"), jen.Id("_")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
+						jen.Id("id"),
+						jen.Id("index"),
+						jen.Id("cache"),
+					),
+					jen.Return(jen.Op("&").Id("entry"), jen.Nil()),
+				)
+			}
+		}),
+		jen.Return(jen.Nil(), jen.Qual("fmt", "Errorf").Call(
+			jen.Lit("unknown content type %s"),
+			jen.Id("data.Items").Index(jen.Lit(0)).Dot("Sys.ContentType.Sys.ID"),
+		)),
 	)
 
 	f.Comment("ContentClient implements a space specific contentful client")
@@ -87,6 +246,94 @@ func generateContentClient(f *jen.File) {
 		jen.Id("Locale").String(),
 		jen.Id("client").Op("*").Qual("net/http", "Client"),
 		jen.Id("pool").Op("*").Qual("crypto/x509", "CertPool"),
+		jen.Id("limiter").Op("*").Id("rateLimiter"),
+		jen.Id("fetchLinks").Bool(),
+		jen.Id("cache").Op("*").Id("sharedCache"),
+		jen.Id("localesMu").Qual("sync", "RWMutex"),
+		jen.Id("fallbacks").Map(jen.String()).String(),
+		jen.Id("defaultLocale").String(),
+	)
+
+	f.Comment("rateLimiter spaces out requests to stay below a number of requests per second")
+	f.Type().Id("rateLimiter").Struct(
+		jen.Id("mu").Qual("sync", "Mutex"),
+		jen.Id("interval").Qual("time", "Duration"),
+		jen.Id("next").Qual("time", "Time"),
+	)
+
+	f.Func().Id("newRateLimiter").Params(
+		jen.Id("perSecond").Int(),
+	).Op("*").Id("rateLimiter").Block(
+		jen.If(jen.Id("perSecond").Op("<=").Lit(0)).Block(
+			jen.Return(jen.Nil()),
+		),
+		jen.Return(jen.Op("&").Id("rateLimiter").Values(jen.Dict{
+			jen.Id("interval"): jen.Qual("time", "Second").Op("/").Qual("time", "Duration").Call(jen.Id("perSecond")),
+		})),
+	)
+
+	f.Comment("wait blocks until the next request may be sent")
+	f.Func().Params(
+		jen.Id("l").Op("*").Id("rateLimiter"),
+	).Id("wait").Params().Block(
+		jen.If(jen.Id("l").Op("==").Nil()).Block(
+			jen.Return(),
+		),
+		jen.Id("l.mu").Dot("Lock").Call(),
+		jen.Id("now").Op(":=").Qual("time", "Now").Call(),
+		jen.If(jen.Id("l.next").Dot("Before").Call(jen.Id("now"))).Block(
+			jen.Id("l.next").Op("=").Id("now"),
+		),
+		jen.Id("delay").Op(":=").Id("l.next").Dot("Sub").Call(jen.Id("now")),
+		jen.Id("l.next").Op("=").Id("l.next").Dot("Add").Call(jen.Id("l.interval")),
+		jen.Id("l.mu").Dot("Unlock").Call(),
+		jen.Qual("time", "Sleep").Call(jen.Id("delay")),
+	)
+
+	f.Comment("SetRateLimit limits the client to perSecond requests. Zero or less disables rate limiting")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("SetRateLimit").Params(
+		jen.Id("perSecond").Int(),
+	).Block(
+		jen.Id("c.limiter").Op("=").Id("newRateLimiter").Call(jen.Id("perSecond")),
+	)
+
+	f.Comment("maxRateLimitAttempts bounds the attempts of a request answered with 429 Too Many Requests")
+	f.Const().Id("maxRateLimitAttempts").Op("=").Lit(3)
+
+	f.Comment("get executes a rate limited GET request. Requests exceeding the api rate limit are retried after the delay requested by the api")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("get").Params(
+		jen.Id("url").String(),
+	).Params(
+		jen.Op("*").Qual("net/http", "Response"), jen.Error(),
+	).Block(
+		jen.For(jen.Id("attempt").Op(":=").Lit(1), jen.Empty(), jen.Id("attempt").Op("++")).Block(
+			jen.Id("c.limiter").Dot("wait").Call(),
+			jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.client.Get").Call(jen.Id("url")),
+			jen.If(jen.Err().Op("!=").Nil().Op("||").Id("resp.StatusCode").Op("!=").Qual("net/http", "StatusTooManyRequests").Op("||").Id("attempt").Op(">=").Id("maxRateLimitAttempts")).Block(
+				jen.Return(jen.Id("resp"), jen.Err()),
+			),
+			jen.Id("resp.Body").Dot("Close").Call(),
+			jen.Qual("time", "Sleep").Call(jen.Id("retryAfter").Call(jen.Id("resp"))),
+		),
+	)
+
+	f.Comment("retryAfter returns the delay requested by a 429 response, defaulting to one second")
+	f.Func().Id("retryAfter").Params(
+		jen.Id("resp").Op("*").Qual("net/http", "Response"),
+	).Qual("time", "Duration").Block(
+		jen.For(jen.List(jen.Id("_"), jen.Id("header")).Op(":=").Range().Index().String().Values(jen.Lit("Retry-After"), jen.Lit("X-Contentful-RateLimit-Reset"))).Block(
+			jen.If(
+				jen.List(jen.Id("seconds"), jen.Err()).Op(":=").Qual("strconv", "Atoi").Call(jen.Id("resp.Header").Dot("Get").Call(jen.Id("header"))),
+				jen.Err().Op("==").Nil().Op("&&").Id("seconds").Op(">=").Lit(0),
+			).Block(
+				jen.Return(jen.Qual("time", "Duration").Call(jen.Id("seconds")).Op("*").Qual("time", "Second")),
+			),
+		),
+		jen.Return(jen.Qual("time", "Second")),
 	)
 
 	f.Comment("contentfulCDAURL points to the contentful delivery api endpoint")
@@ -98,6 +345,15 @@ func generateContentClient(f *jen.File) {
 	f.Comment("contentfulCDAURL points to the contentful management api endpoint")
 	f.Const().Id("contentfulCMAURL").Op("=").Lit(cmaEndpoint)
 
+	f.Comment("contentfulUploadURL points to the contentful upload api endpoint")
+	f.Const().Id("contentfulUploadURL").Op("=").Lit(uploadEndpoint)
+
+	f.Comment("cdaRateLimit is the default number of requests per second sent to the delivery api")
+	f.Const().Id("cdaRateLimit").Op("=").Lit(55)
+
+	f.Comment("cpaRateLimit is the default number of requests per second sent to the preview api")
+	f.Const().Id("cpaRateLimit").Op("=").Lit(14)
+
 	f.Comment("NewCDA returns a contentful client interfacing with the content delivery api")
 	f.Func().Id("NewCDA").Params(
 		jen.Id("authToken").String(),
@@ -111,6 +367,7 @@ func generateContentClient(f *jen.File) {
 			jen.Id("authToken"): jen.Id("authToken"),
 			jen.Id("Locale"):    jen.Id("locale"),
 			jen.Id("pool"):      jen.Id("pool"),
+			jen.Id("limiter"):   jen.Id("newRateLimiter").Call(jen.Id("cdaRateLimit")),
 			jen.Id("client"): jen.Op("&").Qual("net/http", "Client").Values(jen.Dict{
 				jen.Id("Transport"): jen.Op("&").Qual("net/http", "Transport").Values(jen.Dict{
 					jen.Id("TLSClientConfig"): jen.Op("&").Qual("crypto/tls", "Config").Values(jen.Dict{
@@ -134,6 +391,7 @@ func generateContentClient(f *jen.File) {
 			jen.Id("authToken"): jen.Id("authToken"),
 			jen.Id("Locale"):    jen.Id("locale"),
 			jen.Id("pool"):      jen.Id("pool"),
+			jen.Id("limiter"):   jen.Id("newRateLimiter").Call(jen.Id("cpaRateLimit")),
 			jen.Id("client"): jen.Op("&").Qual("net/http", "Client").Values(jen.Dict{
 				jen.Id("Transport"): jen.Op("&").Qual("net/http", "Transport").Values(jen.Dict{
 					jen.Id("TLSClientConfig"): jen.Op("&").Qual("crypto/tls", "Config").Values(jen.Dict{
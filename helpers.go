@@ -1,6 +1,11 @@
 package main
 
-import "github.com/dave/jennifer/jen"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+)
 
 func merge(dicts ...jen.Dict) jen.Dict {
 	d := jen.Dict{}
@@ -11,3 +16,15 @@ func merge(dicts ...jen.Dict) jen.Dict {
 	}
 	return d
 }
+
+// sortedKeys returns the keys of d ordered by their rendered code, so ranging over a Dict generates the same output on every run
+func sortedKeys(d jen.Dict) []jen.Code {
+	keys := make([]jen.Code, 0, len(d))
+	for k := range d {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return fmt.Sprintf("%#v", keys[i]) < fmt.Sprintf("%#v", keys[j])
+	})
+	return keys
+}
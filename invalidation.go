@@ -0,0 +1,133 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+func generateWebhookHandler(f *jen.File) {
+	f.Comment("webhookSignatureTTL is the maximum age of a signed webhook request")
+	f.Const().Id("webhookSignatureTTL").Op("=").Lit(30).Op("*").Qual("time", "Second")
+
+	f.Comment("WebhookHandler evicts entries and assets changed in contentful, as well as all cached entries linking to them, from the client cache.")
+	f.Comment("All requests are rejected unless Secret or the basic auth credentials are set")
+	f.Type().Id("WebhookHandler").Struct(
+		jen.Comment("Secret is the signing secret of the webhook. Requests without a valid signature are rejected if set"),
+		jen.Id("Secret").String(),
+		jen.Comment("Username and Password are the expected basic auth credentials if set"),
+		jen.Id("Username").String(),
+		jen.Id("Password").String(),
+		jen.Id("c").Op("*").Id("ContentClient"),
+	)
+
+	f.Comment("WebhookHandler returns a handler for Entry, Asset and ContentType webhook calls invalidating the client cache")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("WebhookHandler").Params().Op("*").Id("WebhookHandler").Block(
+		jen.Return(jen.Op("&").Id("WebhookHandler").Values(jen.Dict{
+			jen.Id("c"): jen.Id("c"),
+		})),
+	)
+
+	f.Func().Params(
+		jen.Id("h").Op("*").Id("WebhookHandler"),
+	).Id("ServeHTTP").Params(
+		jen.Id("w").Qual("net/http", "ResponseWriter"),
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+	).Block(
+		jen.List(jen.Id("body"), jen.Err()).Op(":=").Qual("io", "ReadAll").Call(jen.Id("r.Body")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Err().Dot("Error").Call(), jen.Qual("net/http", "StatusBadRequest")),
+			jen.Return(),
+		),
+		jen.If(jen.Op("!").Id("h").Dot("verify").Call(jen.Id("r"), jen.Id("body"))).Block(
+			jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Lit("invalid webhook request"), jen.Qual("net/http", "StatusUnauthorized")),
+			jen.Return(),
+		),
+		jen.Var().Id("payload").Struct(
+			jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
+		),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("body"), jen.Op("&").Id("payload")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Err().Dot("Error").Call(), jen.Qual("net/http", "StatusBadRequest")),
+			jen.Return(),
+		),
+		jen.Comment("topics look like ContentManagement.Entry.publish"),
+		jen.Id("topic").Op(":=").Qual("strings", "Split").Call(jen.Id("r.Header").Dot("Get").Call(jen.Lit("X-Contentful-Topic")), jen.Lit(".")),
+		jen.If(jen.Len(jen.Id("topic")).Op("!=").Lit(3)).Block(
+			jen.Qual("net/http", "Error").Call(jen.Id("w"), jen.Lit("unknown webhook topic"), jen.Qual("net/http", "StatusBadRequest")),
+			jen.Return(),
+		),
+		jen.Switch(jen.Id("topic").Index(jen.Lit(1))).Block(
+			jen.Case(jen.Lit("Entry")).Block(
+				jen.Id("h.c.cache").Dot("evict").Call(jen.Lit("Entry:").Op("+").Id("payload.Sys.ID")),
+			),
+			jen.Case(jen.Lit("Asset")).Block(
+				jen.Id("h.c.cache").Dot("evict").Call(jen.Lit("Asset:").Op("+").Id("payload.Sys.ID")),
+			),
+			jen.Case(jen.Lit("ContentType")).Block(
+				jen.Id("h.c.cache").Dot("evictContentType").Call(jen.Id("payload.Sys.ID")),
+			),
+		),
+		jen.Id("w").Dot("WriteHeader").Call(jen.Qual("net/http", "StatusNoContent")),
+	)
+
+	f.Comment("verify checks the basic auth credentials and the request signature, if configured. Requests to a handler without either are rejected")
+	f.Func().Params(
+		jen.Id("h").Op("*").Id("WebhookHandler"),
+	).Id("verify").Params(
+		jen.Id("r").Op("*").Qual("net/http", "Request"),
+		jen.Id("body").Index().Byte(),
+	).Bool().Block(
+		jen.Id("basicAuth").Op(":=").Id("h.Username").Op("!=").Lit("").Op("||").Id("h.Password").Op("!=").Lit(""),
+		jen.If(jen.Op("!").Id("basicAuth").Op("&&").Id("h.Secret").Op("==").Lit("")).Block(
+			jen.Return(jen.False()),
+		),
+		jen.If(jen.Id("basicAuth")).Block(
+			jen.List(jen.Id("username"), jen.Id("password"), jen.Id("ok")).Op(":=").Id("r").Dot("BasicAuth").Call(),
+			jen.If(
+				jen.Op("!").Id("ok").Op("||").
+					Qual("crypto/subtle", "ConstantTimeCompare").Call(jen.Index().Byte().Parens(jen.Id("username")), jen.Index().Byte().Parens(jen.Id("h.Username"))).Op("!=").Lit(1).Op("||").
+					Qual("crypto/subtle", "ConstantTimeCompare").Call(jen.Index().Byte().Parens(jen.Id("password")), jen.Index().Byte().Parens(jen.Id("h.Password"))).Op("!=").Lit(1),
+			).Block(
+				jen.Return(jen.False()),
+			),
+		),
+		jen.If(jen.Id("h.Secret").Op("==").Lit("")).Block(
+			jen.Return(jen.True()),
+		),
+		jen.List(jen.Id("timestamp"), jen.Err()).Op(":=").Qual("strconv", "ParseInt").Call(jen.Id("r.Header").Dot("Get").Call(jen.Lit("X-Contentful-Timestamp")), jen.Lit(10), jen.Lit(64)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.False()),
+		),
+		jen.Id("age").Op(":=").Qual("time", "Since").Call(jen.Qual("time", "UnixMilli").Call(jen.Id("timestamp"))),
+		jen.If(jen.Id("age").Op(">").Id("webhookSignatureTTL").Op("||").Id("age").Op("<").Op("-").Id("webhookSignatureTTL")).Block(
+			jen.Return(jen.False()),
+		),
+		jen.Comment("the canonical request is the method, path, signed headers and body separated by newlines"),
+		jen.Var().Id("headers").Index().String(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("name")).Op(":=").Range().Qual("strings", "Split").Call(jen.Id("r.Header").Dot("Get").Call(jen.Lit("X-Contentful-Signed-Headers")), jen.Lit(","))).Block(
+			jen.Id("name").Op("=").Qual("strings", "ToLower").Call(jen.Qual("strings", "TrimSpace").Call(jen.Id("name"))),
+			jen.Id("value").Op(":=").Id("r.Header").Dot("Get").Call(jen.Id("name")),
+			jen.If(jen.Id("name").Op("==").Lit("host")).Block(
+				jen.Id("value").Op("=").Id("r.Host"),
+			),
+			jen.Id("headers").Op("=").Append(jen.Id("headers"), jen.Id("name").Op("+").Lit(":").Op("+").Id("value")),
+		),
+		jen.Id("canonical").Op(":=").Qual("strings", "Join").Call(
+			jen.Index().String().Values(
+				jen.Id("r.Method"),
+				jen.Id("r.URL").Dot("RequestURI").Call(),
+				jen.Qual("strings", "Join").Call(jen.Id("headers"), jen.Lit(";")),
+				jen.String().Parens(jen.Id("body")),
+			),
+			jen.Lit("\n"),
+		),
+		jen.Id("mac").Op(":=").Qual("crypto/hmac", "New").Call(jen.Qual("crypto/sha256", "New"), jen.Index().Byte().Parens(jen.Id("h.Secret"))),
+		jen.Id("mac").Dot("Write").Call(jen.Index().Byte().Parens(jen.Id("canonical"))),
+		jen.Id("expected").Op(":=").Qual("encoding/hex", "EncodeToString").Call(jen.Id("mac").Dot("Sum").Call(jen.Nil())),
+		jen.Return(jen.Qual("crypto/hmac", "Equal").Call(
+			jen.Index().Byte().Parens(jen.Id("expected")),
+			jen.Index().Byte().Parens(jen.Id("r.Header").Dot("Get").Call(jen.Lit("X-Contentful-Signature"))),
+		)),
+	)
+}
@@ -4,12 +4,243 @@ import "github.com/dave/jennifer/jen"
 
 func generateIteratorUtils(f *jen.File) {
 	f.Comment("ErrIteratorDone is used to indicate that the iterator has no more data")
-	f.Var().Id("ErrIteratorDone").Op("=").Qual("fmt", "Errorf").Call(jen.Lit("IteratorDone"))
+	f.Var().Id("ErrIteratorDone").Op("=").Qual("errors", "New").Call(jen.Lit("IteratorDone"))
 
-	f.Comment("ListOptions contains pagination configuration for iterators")
+	f.Comment("ListOptions contains pagination configuration for iterators. Prefetch is the number of pages entry iterators request ahead concurrently. Locale overrides the client locale if set")
 	f.Type().Id("ListOptions").Struct(
 		jen.Id("Page").Int(),
 		jen.Id("Limit").Int(),
 		jen.Id("IncludeCount").Int(),
+		jen.Id("Prefetch").Int(),
+		jen.Id("Locale").String(),
+	)
+
+	f.Comment("pageResult holds a prefetched raw page")
+	f.Type().Id("pageResult").Types(jen.Id("T").Any()).Struct(
+		jen.Id("data").Id("T"),
+		jen.Err().Error(),
+	)
+
+	f.Comment("prefetcher requests the following pages of an iterator in the background while the current page is consumed")
+	f.Type().Id("prefetcher").Types(jen.Id("T").Any()).Struct(
+		jen.Id("pages").Int(),
+		jen.Id("next").Int(),
+		jen.Id("failed").Bool(),
+		jen.Id("pending").Index().Chan().Id("pageResult").Types(jen.Id("T")),
+	)
+
+	f.Comment("fill starts requests for up to p.pages pages following offset, stopping at total")
+	f.Func().Params(
+		jen.Id("p").Op("*").Id("prefetcher").Types(jen.Id("T")),
+	).Id("fill").Params(
+		jen.List(jen.Id("offset"), jen.Id("limit"), jen.Id("total")).Int(),
+		jen.Id("request").Func().Params(jen.Id("offset").Int()).Params(jen.Id("T"), jen.Error()),
+	).Block(
+		jen.If(jen.Id("p").Op("==").Nil().Op("||").Id("p.failed")).Block(
+			jen.Return(),
+		),
+		jen.If(jen.Id("p.next").Op("<").Id("offset")).Block(
+			jen.Id("p.next").Op("=").Id("offset"),
+		),
+		jen.For(jen.Len(jen.Id("p.pending")).Op("<").Id("p.pages").Op("&&").Id("p.next").Op("<").Id("total")).Block(
+			jen.Id("ch").Op(":=").Make(jen.Chan().Id("pageResult").Types(jen.Id("T")), jen.Lit(1)),
+			jen.Go().Func().Params(jen.Id("offset").Int()).Block(
+				jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("request").Call(jen.Id("offset")),
+				jen.Id("ch").Op("<-").Id("pageResult").Types(jen.Id("T")).Values(jen.Dict{
+					jen.Id("data"): jen.Id("data"),
+					jen.Err():      jen.Err(),
+				}),
+			).Call(jen.Id("p.next")),
+			jen.Id("p.pending").Op("=").Append(jen.Id("p.pending"), jen.Id("ch")),
+			jen.Id("p.next").Op("+=").Id("limit"),
+		),
+	)
+
+	f.Comment("take waits for the oldest pending page. ok is false if no page is pending")
+	f.Func().Params(
+		jen.Id("p").Op("*").Id("prefetcher").Types(jen.Id("T")),
+	).Id("take").Params().Params(
+		jen.Id("data").Id("T"),
+		jen.Id("ok").Bool(),
+		jen.Err().Error(),
+	).Block(
+		jen.If(jen.Id("p").Op("==").Nil().Op("||").Len(jen.Id("p.pending")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Id("data"), jen.False(), jen.Nil()),
+		),
+		jen.Id("result").Op(":=").Op("<-").Id("p.pending").Index(jen.Lit(0)),
+		jen.Id("p.pending").Op("=").Id("p.pending").Index(jen.Lit(1), jen.Empty()),
+		jen.If(jen.Id("result.err").Op("!=").Nil()).Block(
+			jen.Comment("stop prefetching, later pages are requested synchronously again"),
+			jen.Id("p.failed").Op("=").True(),
+			jen.Id("p.pending").Op("=").Nil(),
+		),
+		jen.Return(jen.Id("result.data"), jen.True(), jen.Id("result.err")),
+	)
+}
+
+// iteratorFields returns the pagination state shared by all generated iterators
+func iteratorFields() []jen.Code {
+	return []jen.Code{
+		jen.Id("Limit").Int(),
+		jen.Id("Offset").Int(),
+		jen.Id("total").Int(),
+		jen.Id("fetched").Bool(),
+	}
+}
+
+// generateIteratorMethods adds Next, Page, Total and HasMore to an iterator
+// which implements fetch() and uses iteratorFields
+func generateIteratorMethods(f *jen.File, iterator, item string) {
+	f.Comment("setPage records a fetched page in the pagination state")
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("setPage").Params(
+		jen.Id("items").Index().Op("*").Id(item),
+		jen.Id("total").Int(),
+	).Block(
+		jen.Id("it.items").Op("=").Id("items"),
+		jen.Id("it.total").Op("=").Id("total"),
+		jen.Id("it.fetched").Op("=").True(),
+		jen.Id("it.Offset").Op("+=").Len(jen.Id("items")),
+		jen.If(jen.Len(jen.Id("items")).Op("==").Lit(0)).Block(
+			jen.Comment("items were removed since the total was reported"),
+			jen.Id("it.total").Op("=").Id("it.Offset"),
+		),
+	)
+
+	fill := []jen.Code{
+		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
+			jen.If(jen.Op("!").Id("it.HasMore").Call()).Block(
+				jen.Return(jen.Nil(), jen.Id("ErrIteratorDone")),
+			),
+			jen.If(
+				jen.Err().Op(":=").Id("it.fetch").Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+		),
+		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Nil(), jen.Id("ErrIteratorDone")),
+		),
+	}
+
+	f.Commentf("Next returns the following item of type %s. If none exists a network request will be executed", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("Next").Params().Params(
+		jen.Op("*").Id(item), jen.Id("error"),
+	).BlockFunc(func(g *jen.Group) {
+		for _, c := range fill {
+			g.Add(c)
+		}
+		g.Var().Id("item").Op("*").Id(item)
+		g.List(
+			jen.Id("item"),
+			jen.Id("it.items"),
+		).Op("=").List(
+			jen.Id("it.items").Index(jen.Lit(0)),
+			jen.Id("it.items").Index(jen.Lit(1), jen.Empty()),
+		)
+		g.Return(jen.Id("item, nil"))
+	})
+
+	f.Commentf("Page returns the remaining items of the current page of %s. If none exists a network request will be executed", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("Page").Params().Params(
+		jen.Index().Op("*").Id(item), jen.Id("error"),
+	).BlockFunc(func(g *jen.Group) {
+		for _, c := range fill {
+			g.Add(c)
+		}
+		g.Id("items").Op(":=").Id("it.items")
+		g.Id("it.items").Op("=").Nil()
+		g.Return(jen.Id("items, nil"))
+	})
+
+	f.Commentf("All returns a range-over-func sequence of all remaining %s items. Iteration stops after the first error", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("All").Params().Qual("iter", "Seq2").Types(jen.Op("*").Id(item), jen.Error()).Block(
+		jen.Return(jen.Func().Params(
+			jen.Id("yield").Func().Params(jen.Op("*").Id(item), jen.Error()).Bool(),
+		).Block(
+			jen.For().Block(
+				jen.List(jen.Id("item"), jen.Err()).Op(":=").Id("it.Next").Call(),
+				jen.If(jen.Qual("errors", "Is").Call(jen.Err(), jen.Id("ErrIteratorDone"))).Block(
+					jen.Return(),
+				),
+				jen.If(jen.Op("!").Id("yield").Call(jen.Id("item"), jen.Err()).Op("||").Err().Op("!=").Nil()).Block(
+					jen.Return(),
+				),
+			),
+		)),
+	)
+
+	f.Commentf("Collect returns up to max remaining %s items. A max of zero or less collects all items", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("Collect").Params(
+		jen.Id("max").Int(),
+	).Params(
+		jen.Index().Op("*").Id(item), jen.Error(),
+	).Block(
+		jen.Var().Id("items").Index().Op("*").Id(item),
+		jen.For(jen.List(jen.Id("item"), jen.Err()).Op(":=").Range().Id("it.All").Call()).Block(
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Id("items"), jen.Err()),
+			),
+			jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("item")),
+			jen.If(jen.Id("max").Op(">").Lit(0).Op("&&").Len(jen.Id("items")).Op(">=").Id("max")).Block(
+				jen.Break(),
+			),
+		),
+		jen.Return(jen.Id("items"), jen.Nil()),
+	)
+
+	f.Commentf("ForEach calls fn for every remaining %s item and stops at the first error", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("ForEach").Params(
+		jen.Id("fn").Func().Params(jen.Op("*").Id(item)).Error(),
+	).Error().Block(
+		jen.For(jen.List(jen.Id("item"), jen.Err()).Op(":=").Range().Id("it.All").Call()).Block(
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			),
+			jen.If(jen.Err().Op(":=").Id("fn").Call(jen.Id("item")), jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			),
+		),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("Total returns the number of items matching the iterator. The first page is fetched if necessary")
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("Total").Params().Params(
+		jen.Int(), jen.Id("error"),
+	).Block(
+		jen.If(jen.Op("!").Id("it.fetched")).Block(
+			jen.If(
+				jen.Err().Op(":=").Id("it.fetch").Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Lit(0), jen.Err()),
+			),
+		),
+		jen.Return(jen.Id("it.total"), jen.Nil()),
+	)
+
+	f.Commentf("HasMore reports whether Next can return further items of type %s", item)
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("HasMore").Params().Bool().Block(
+		jen.Return(
+			jen.Len(jen.Id("it.items")).Op(">").Lit(0).Op("||").
+				Op("!").Id("it.fetched").Op("||").
+				Id("it.Offset").Op("<").Id("it.total"),
+		),
 	)
 }
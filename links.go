@@ -0,0 +1,385 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// linkedContentTypeID returns the content type ID a link is restricted to, or an empty string for multi-type links
+func linkedContentTypeID(vs []validation) string {
+	var linked = linkedContentTypes(vs)
+	if len(linked) != 1 {
+		return ""
+	}
+	for _, m := range models {
+		if m.Name == linked[0] {
+			return m.Sys.ID
+		}
+	}
+	return ""
+}
+
+func generateLinkFetcher(f *jen.File) {
+	f.Comment("maxLinkBatch is the number of IDs requested at once when fetching missing links")
+	f.Const().Id("maxLinkBatch").Op("=").Lit(100)
+
+	f.Comment("linkSet collects linked entry IDs grouped by content type and linked asset IDs")
+	f.Type().Id("linkSet").Struct(
+		jen.Id("entries").Map(jen.String()).Index().String(),
+		jen.Id("assets").Index().String(),
+	)
+
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("linkSet"),
+	).Id("entry").Params(
+		jen.List(jen.Id("contentType"), jen.Id("id")).String(),
+	).Block(
+		jen.If(jen.Id("id").Op("!=").Lit("")).Block(
+			jen.Id("s.entries").Index(jen.Id("contentType")).Op("=").Append(jen.Id("s.entries").Index(jen.Id("contentType")), jen.Id("id")),
+		),
+	)
+
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("linkSet"),
+	).Id("asset").Params(
+		jen.Id("id").String(),
+	).Block(
+		jen.If(jen.Id("id").Op("!=").Lit("")).Block(
+			jen.Id("s.assets").Op("=").Append(jen.Id("s.assets"), jen.Id("id")),
+		),
+	)
+
+	f.Comment("entryLinks adds all links of a raw entry to the link set")
+	f.Func().Id("entryLinks").Params(
+		jen.Id("entry").Op("*").Id("includeEntry"),
+		jen.Id("links").Op("*").Id("linkSet"),
+	).Error().Block(
+		jen.Switch(jen.Id("entry.Sys.ContentType.Sys.ID")).BlockFunc(func(g *jen.Group) {
+			for _, m := range models {
+				g.Case(jen.Lit(m.Sys.ID)).BlockFunc(func(g *jen.Group) {
+					g.Var().Id("item").Id(fmt.Sprintf("%sItem", m.DowncasedName()))
+					g.If(
+						jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(
+							jen.Op("*").Id("entry.Fields"),
+							jen.Op("&").Id("item.Fields"),
+						),
+						jen.Err().Op("!=").Nil(),
+					).Block(
+						jen.Return(jen.Err()),
+					)
+					for _, field := range m.Fields {
+						fieldName := fieldName(field)
+						switch field.Type {
+						case "Link":
+							if field.LinkType == "Asset" {
+								g.Id("links").Dot("asset").Call(jen.Id("item.Fields").Dot(fieldName).Dot("Sys.ID"))
+							} else {
+								g.Id("links").Dot("entry").Call(jen.Lit(linkedContentTypeID(field.Validations)), jen.Id("item.Fields").Dot(fieldName).Dot("Sys.ID"))
+							}
+						case "Array":
+							if field.Items.Type == "Link" {
+								g.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("item.Fields").Dot(fieldName)).Block(
+									jen.Id("links").Dot("entry").Call(jen.Lit(linkedContentTypeID(field.Items.Validations)), jen.Id("id.Sys.ID")),
+								)
+							}
+						}
+					}
+				})
+			}
+		}),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("SetFetchMissingLinks enables fetching links which are not part of a response, e.g. beyond the include depth, with additional requests")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("SetFetchMissingLinks").Params(
+		jen.Id("enabled").Bool(),
+	).Block(
+		jen.Id("c.fetchLinks").Op("=").Id("enabled"),
+	)
+
+	f.Comment("completeLinks fetches linked entries and assets missing from the index until every link of the index can be resolved. Fetched links are kept in the cache for later responses")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("completeLinks").Params(
+		jen.Id("index").Op("*").Id("linkIndex"),
+		jen.Id("cache").Op("*").Id("iteratorCache"),
+	).Error().Block(
+		jen.Var().Id("pending").Index().Op("*").Id("includeEntry"),
+		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("index.entries")).Block(
+			jen.Id("pending").Op("=").Append(jen.Id("pending"), jen.Id("entry")),
+		),
+		jen.Id("requested").Op(":=").Map(jen.String()).Bool().Values(),
+		jen.For(jen.Len(jen.Id("pending")).Op(">").Lit(0)).Block(
+			jen.Id("links").Op(":=").Op("&").Id("linkSet").Values(jen.Dict{
+				jen.Id("entries"): jen.Map(jen.String()).Index().String().Values(),
+			}),
+			jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("pending")).Block(
+				jen.If(
+					jen.Err().Op(":=").Id("entryLinks").Call(jen.Id("entry"), jen.Id("links")),
+					jen.Err().Op("!=").Nil(),
+				).Block(
+					jen.Return(jen.Err()),
+				),
+			),
+			jen.Id("pending").Op("=").Nil(),
+
+			jen.For(jen.List(jen.Id("contentType"), jen.Id("ids")).Op(":=").Range().Id("links.entries")).Block(
+				jen.Var().Id("missing").Index().String(),
+				jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+					jen.If(
+						jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("index.entries").Index(jen.Id("id")),
+						jen.Id("ok").Op("||").Id("requested").Index(jen.Id("id")).Op("||").Id("cache").Dot("has").Call(jen.Id("id")),
+					).Block(
+						jen.Continue(),
+					),
+					jen.Id("requested").Index(jen.Id("id")).Op("=").True(),
+					jen.If(
+						jen.List(jen.Id("entry"), jen.Id("ok")).Op(":=").Id("cache.fetched.entries").Index(jen.Id("id")),
+						jen.Id("ok"),
+					).Block(
+						jen.Id("index.entries").Index(jen.Id("id")).Op("=").Id("entry"),
+						jen.Id("pending").Op("=").Append(jen.Id("pending"), jen.Id("entry")),
+						jen.Continue(),
+					),
+					jen.Id("missing").Op("=").Append(jen.Id("missing"), jen.Id("id")),
+				),
+				jen.For(jen.Id("start").Op(":=").Lit(0), jen.Id("start").Op("<").Len(jen.Id("missing")), jen.Id("start").Op("+=").Id("maxLinkBatch")).Block(
+					jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("c.requestLinkedEntries").Call(
+						jen.Id("cache.locale"),
+						jen.Id("contentType"),
+						jen.Id("missing").Index(jen.Id("start").Op(":").Min(jen.Id("start").Op("+").Id("maxLinkBatch"), jen.Len(jen.Id("missing")))),
+					),
+					jen.If(jen.Err().Op("!=").Nil()).Block(
+						jen.Return(jen.Err()),
+					),
+					jen.For(jen.Id("i").Op(":=").Range().Id("items")).Block(
+						jen.Id("entry").Op(":=").Op("&").Id("items").Index(jen.Id("i")),
+						jen.Id("index.entries").Index(jen.Id("entry.Sys.ID")).Op("=").Id("entry"),
+						jen.Id("cache.fetched.entries").Index(jen.Id("entry.Sys.ID")).Op("=").Id("entry"),
+						jen.Id("pending").Op("=").Append(jen.Id("pending"), jen.Id("entry")),
+					),
+				),
+			),
+
+			jen.Var().Id("missing").Index().String(),
+			jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("links.assets")).Block(
+				jen.If(
+					jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("index.assets").Index(jen.Id("id")),
+					jen.Id("ok").Op("||").Id("requested").Index(jen.Id("id")),
+				).Block(
+					jen.Continue(),
+				),
+				jen.If(
+					jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("cache.shared").Dot("get").Call(jen.Lit("Asset:").Op("+").Id("id")),
+					jen.Id("ok"),
+				).Block(
+					jen.Continue(),
+				),
+				jen.Id("requested").Index(jen.Id("id")).Op("=").True(),
+				jen.If(
+					jen.List(jen.Id("asset"), jen.Id("ok")).Op(":=").Id("cache.fetched.assets").Index(jen.Id("id")),
+					jen.Id("ok"),
+				).Block(
+					jen.Id("index.assets").Index(jen.Id("id")).Op("=").Id("asset"),
+					jen.Continue(),
+				),
+				jen.Id("missing").Op("=").Append(jen.Id("missing"), jen.Id("id")),
+			),
+			jen.For(jen.Id("start").Op(":=").Lit(0), jen.Id("start").Op("<").Len(jen.Id("missing")), jen.Id("start").Op("+=").Id("maxLinkBatch")).Block(
+				jen.List(jen.Id("assets"), jen.Err()).Op(":=").Id("c.requestLinkedAssets").Call(
+					jen.Id("cache.locale"),
+					jen.Id("missing").Index(jen.Id("start").Op(":").Min(jen.Id("start").Op("+").Id("maxLinkBatch"), jen.Len(jen.Id("missing")))),
+				),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Err()),
+				),
+				jen.For(jen.Id("i").Op(":=").Range().Id("assets")).Block(
+					jen.Id("asset").Op(":=").Op("&").Id("assets").Index(jen.Id("i")),
+					jen.Id("index.assets").Index(jen.Id("asset.Sys.ID")).Op("=").Id("asset"),
+					jen.Id("cache.fetched.assets").Index(jen.Id("asset.Sys.ID")).Op("=").Id("asset"),
+				),
+			),
+		),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("requestLinkedEntries requests entries by ID, restricted to a content type unless it is empty")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("requestLinkedEntries").Params(
+		jen.Id("locale").String(),
+		jen.Id("contentType").String(),
+		jen.Id("ids").Index().String(),
+	).Params(
+		jen.Index().Id("includeEntry"), jen.Error(),
+	).Block(
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("locale")),
+		jen.Id("query").Op(":=").Qual("net/url", "QueryEscape").Call(jen.Qual("strings", "Join").Call(jen.Id("ids"), jen.Lit(","))),
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/entries?access_token=%s&locale=%s&include=0&limit=%d&sys.id[in]=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+			jen.Len(jen.Id("ids")),
+			jen.Id("query"),
+		),
+		jen.If(jen.Id("contentType").Op("!=").Lit("")).Block(
+			jen.Id("url").Op("+=").Lit("&content_type=").Op("+").Id("contentType"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("entriesResponse"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Id("data.Items"), jen.Nil()),
+	)
+
+	f.Comment("requestLinkedAssets requests assets by ID")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("requestLinkedAssets").Params(
+		jen.Id("locale").String(),
+		jen.Id("ids").Index().String(),
+	).Params(
+		jen.Index().Id("includeAsset"), jen.Error(),
+	).Block(
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("locale")),
+		jen.Id("query").Op(":=").Qual("net/url", "QueryEscape").Call(jen.Qual("strings", "Join").Call(jen.Id("ids"), jen.Lit(","))),
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/assets?access_token=%s&locale=%s&limit=%d&sys.id[in]=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+			jen.Len(jen.Id("ids")),
+			jen.Id("query"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("assetsResponse"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Id("data.Items"), jen.Nil()),
+	)
+}
+
+// linkInterfaceName names the sealed interface implemented by the content types allowed in a multi-type link field
+func linkInterfaceName(m contentfulModel, f field) string {
+	return fmt.Sprintf("%s%sItem", m.Name, fieldName(f))
+}
+
+// multiTypeLinks returns the fields of a model linking to several content types together with the linked model names
+func multiTypeLinks(m contentfulModel) ([]field, [][]string) {
+	var fields []field
+	var linked [][]string
+	for _, field := range m.Fields {
+		var linkedTypes []string
+		switch {
+		case field.Type == "Link" && field.LinkType == "Entry":
+			linkedTypes = linkedContentTypes(field.Validations)
+		case field.Type == "Array" && field.Items.Type == "Link":
+			linkedTypes = linkedContentTypes(field.Items.Validations)
+		}
+		if len(linkedTypes) > 1 {
+			fields = append(fields, field)
+			linked = append(linked, linkedTypes)
+		}
+	}
+	return fields, linked
+}
+
+func generateLinkTypes(f *jen.File) {
+	f.Comment("Entry is implemented by every generated content type. It is used for links without content type restrictions")
+	f.Type().Id("Entry").Interface(
+		jen.Id("isEntry").Params(),
+	)
+
+	for _, m := range models {
+		f.Func().Params(jen.Id(m.Name)).Id("isEntry").Params().Block()
+	}
+
+	f.Comment("refAs narrows an entry link to the types allowed by a field. Entries of other types are reported as unresolved")
+	f.Func().Id("refAs").Types(jen.Id("T").Any()).Params(
+		jen.Id("ref").Id("Ref").Types(jen.Id("Entry")),
+	).Id("Ref").Types(jen.Id("T")).Block(
+		jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("ref.Value").Assert(jen.Id("T")),
+		jen.Return(jen.Id("Ref").Types(jen.Id("T")).Values(jen.Dict{
+			jen.Id("ID"):       jen.Id("ref.ID"),
+			jen.Id("Resolved"): jen.Id("ref.Resolved").Op("&&").Id("ok"),
+			jen.Id("Value"):    jen.Id("v"),
+		})),
+	)
+
+	f.Func().Id("refsAs").Types(jen.Id("T").Any()).Params(
+		jen.Id("refs").Index().Id("Ref").Types(jen.Id("Entry")),
+	).Index().Id("Ref").Types(jen.Id("T")).Block(
+		jen.Var().Id("items").Index().Id("Ref").Types(jen.Id("T")),
+		jen.For(jen.List(jen.Id("_"), jen.Id("ref")).Op(":=").Range().Id("refs")).Block(
+			jen.Id("items").Op("=").Append(jen.Id("items"), jen.Id("refAs").Types(jen.Id("T")).Call(jen.Id("ref"))),
+		),
+		jen.Return(jen.Id("items")),
+	)
+}
+
+func generateLinkInterfaces(f *jen.File, m contentfulModel) {
+	fields, linked := multiTypeLinks(m)
+	for i, field := range fields {
+		name := linkInterfaceName(m, field)
+		visitor := name + "Visitor"
+		method := "visit" + name
+
+		f.Commentf("%s is implemented by the content types allowed in %s.%s", name, m.Name, fieldName(field))
+		f.Type().Id(name).Interface(
+			jen.Id(method).Params(jen.Id(visitor)),
+		)
+
+		f.Commentf("%s handles every content type allowed in %s.%s", visitor, m.Name, fieldName(field))
+		f.Type().Id(visitor).InterfaceFunc(func(g *jen.Group) {
+			for _, linkedType := range linked[i] {
+				g.Id("Visit" + linkedType).Params(jen.Id(linkedType))
+			}
+		})
+
+		for _, linkedType := range linked[i] {
+			f.Func().Params(
+				jen.Id("e").Id(linkedType),
+			).Id(method).Params(
+				jen.Id("v").Id(visitor),
+			).Block(
+				jen.Id("v").Dot("Visit" + linkedType).Call(jen.Id("e")),
+			)
+		}
+
+		f.Commentf("Visit%s calls the visitor method matching the content type of item. Nil items are ignored", name)
+		f.Func().Id("Visit"+name).Params(
+			jen.Id("item").Id(name),
+			jen.Id("v").Id(visitor),
+		).Block(
+			jen.If(jen.Id("item").Op("!=").Nil()).Block(
+				jen.Id("item").Dot(method).Call(jen.Id("v")),
+			),
+		)
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+func generateLocales(f *jen.File) {
+	f.Comment("Locale describes a locale of the space. FallbackCode names the locale used for fields without a value, if any")
+	f.Type().Id("Locale").Struct(
+		jen.Id("Code").String().Tag(map[string]string{"json": "code"}),
+		jen.Id("Name").String().Tag(map[string]string{"json": "name"}),
+		jen.Id("Default").Bool().Tag(map[string]string{"json": "default"}),
+		jen.Id("FallbackCode").String().Tag(map[string]string{"json": "fallbackCode"}),
+	)
+
+	f.Comment("Locales retrieves the locales of the space. The client remembers their fallback chains and fills empty fields of later responses along them")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("Locales").Params().Params(
+		jen.Index().Id("Locale"), jen.Error(),
+	).Block(
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/locales?access_token=%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Struct(
+			jen.Id("Items").Index().Id("Locale").Tag(map[string]string{"json": "items"}),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Nil(), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("fallbacks").Op(":=").Make(jen.Map(jen.String()).String(), jen.Len(jen.Id("data.Items"))),
+		jen.Var().Id("defaultLocale").String(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("locale")).Op(":=").Range().Id("data.Items")).Block(
+			jen.Id("fallbacks").Index(jen.Id("locale.Code")).Op("=").Id("locale.FallbackCode"),
+			jen.If(jen.Id("locale.Default")).Block(
+				jen.Id("defaultLocale").Op("=").Id("locale.Code"),
+			),
+		),
+		jen.Id("c.localesMu").Dot("Lock").Call(),
+		jen.Id("c.fallbacks").Op("=").Id("fallbacks"),
+		jen.Id("c.defaultLocale").Op("=").Id("defaultLocale"),
+		jen.Id("c.localesMu").Dot("Unlock").Call(),
+		jen.Return(jen.Id("data.Items"), jen.Nil()),
+	)
+
+	f.Comment("fallbackChain returns locale followed by its fallback locales and the default locale, as far as known from Locales.")
+	f.Comment("The default locale ends every chain because multi locale responses hold fields which are not localized only in the default locale")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("fallbackChain").Params(
+		jen.Id("locale").String(),
+	).Index().String().Block(
+		jen.If(jen.Id("locale").Op("==").Lit("*")).Block(
+			jen.Comment("all locales are requested as they are"),
+			jen.Return(jen.Index().String().Values(jen.Id("locale"))),
+		),
+		jen.Id("c.localesMu").Dot("RLock").Call(),
+		jen.Defer().Id("c.localesMu").Dot("RUnlock").Call(),
+		jen.Id("chain").Op(":=").Index().String().Values(jen.Id("locale")),
+		jen.Id("seen").Op(":=").Map(jen.String()).Bool().Values(jen.Dict{
+			jen.Id("locale"): jen.True(),
+		}),
+		jen.For(jen.Id("next").Op(":=").Id("c.fallbacks").Index(jen.Id("locale")), jen.Id("next").Op("!=").Lit("").Op("&&").Op("!").Id("seen").Index(jen.Id("next")), jen.Id("next").Op("=").Id("c.fallbacks").Index(jen.Id("next"))).Block(
+			jen.Id("seen").Index(jen.Id("next")).Op("=").True(),
+			jen.Id("chain").Op("=").Append(jen.Id("chain"), jen.Id("next")),
+		),
+		jen.If(jen.Id("c.defaultLocale").Op("!=").Lit("").Op("&&").Op("!").Id("seen").Index(jen.Id("c.defaultLocale"))).Block(
+			jen.Id("chain").Op("=").Append(jen.Id("chain"), jen.Id("c.defaultLocale")),
+		),
+		jen.Return(jen.Id("chain")),
+	)
+
+	f.Comment("localeQuery returns the locale parameter for a fallback chain. Chains of several locales request all locales to fill empty fields locally")
+	f.Func().Id("localeQuery").Params(
+		jen.Id("chain").Index().String(),
+	).String().Block(
+		jen.If(jen.Len(jen.Id("chain")).Op(">").Lit(1)).Block(
+			jen.Return(jen.Lit("*")),
+		),
+		jen.Return(jen.Id("chain").Index(jen.Lit(0))),
+	)
+
+	f.Comment("localizedFields picks the values of the first locale of chain that has them from the fields of a multi locale entry or asset")
+	f.Func().Id("localizedFields").Params(
+		jen.Id("fields").Qual("encoding/json", "RawMessage"),
+		jen.Id("chain").Index().String(),
+	).Params(
+		jen.Op("*").Qual("encoding/json", "RawMessage"), jen.Error(),
+	).Block(
+		jen.Var().Id("all").Map(jen.String()).Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("fields"), jen.Op("&").Id("all")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("flat").Op(":=").Make(jen.Map(jen.String()).Qual("encoding/json", "RawMessage"), jen.Len(jen.Id("all"))),
+		jen.For(jen.List(jen.Id("name"), jen.Id("values")).Op(":=").Range().Id("all")).Block(
+			jen.For(jen.List(jen.Id("_"), jen.Id("locale")).Op(":=").Range().Id("chain")).Block(
+				jen.If(
+					jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("values").Index(jen.Id("locale")),
+					jen.Id("ok").Op("&&").String().Call(jen.Id("v")).Op("!=").Lit("null"),
+				).Block(
+					jen.Id("flat").Index(jen.Id("name")).Op("=").Id("v"),
+					jen.Break(),
+				),
+			),
+		),
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("flat")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("raw").Op(":=").Qual("encoding/json", "RawMessage").Call(jen.Id("b")),
+		jen.Return(jen.Op("&").Id("raw"), jen.Nil()),
+	)
+
+	f.Comment("localizeItems localizes the fields of a list of multi locale entries or assets")
+	f.Func().Id("localizeItems").Params(
+		jen.Id("raw").Qual("encoding/json", "RawMessage"),
+		jen.Id("chain").Index().String(),
+	).Params(
+		jen.Qual("encoding/json", "RawMessage"), jen.Error(),
+	).Block(
+		jen.Var().Id("items").Index().Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("raw"), jen.Op("&").Id("items")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("items")).Block(
+			jen.If(
+				jen.List(jen.Id("fields"), jen.Id("ok")).Op(":=").Id("item").Index(jen.Lit("fields")),
+				jen.Id("ok"),
+			).Block(
+				jen.List(jen.Id("localized"), jen.Err()).Op(":=").Id("localizedFields").Call(jen.Id("fields"), jen.Id("chain")),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Err()),
+				),
+				jen.Id("item").Index(jen.Lit("fields")).Op("=").Op("*").Id("localized"),
+			),
+		),
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("items"))),
+	)
+
+	f.Comment("localizeResponse rewrites a multi locale response of a single item or a list with includes into a single locale response along chain")
+	f.Func().Id("localizeResponse").Params(
+		jen.Id("body").Index().Byte(),
+		jen.Id("chain").Index().String(),
+	).Params(
+		jen.Index().Byte(), jen.Error(),
+	).Block(
+		jen.Var().Id("data").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("body"), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(
+			jen.List(jen.Id("fields"), jen.Id("ok")).Op(":=").Id("data").Index(jen.Lit("fields")),
+			jen.Id("ok"),
+		).Block(
+			jen.List(jen.Id("localized"), jen.Err()).Op(":=").Id("localizedFields").Call(jen.Id("fields"), jen.Id("chain")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Id("data").Index(jen.Lit("fields")).Op("=").Op("*").Id("localized"),
+		),
+		jen.If(
+			jen.List(jen.Id("items"), jen.Id("ok")).Op(":=").Id("data").Index(jen.Lit("items")),
+			jen.Id("ok"),
+		).Block(
+			jen.List(jen.Id("localized"), jen.Err()).Op(":=").Id("localizeItems").Call(jen.Id("items"), jen.Id("chain")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Id("data").Index(jen.Lit("items")).Op("=").Id("localized"),
+		),
+		jen.If(
+			jen.List(jen.Id("raw"), jen.Id("ok")).Op(":=").Id("data").Index(jen.Lit("includes")),
+			jen.Id("ok"),
+		).Block(
+			jen.Var().Id("includes").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("raw"), jen.Op("&").Id("includes")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.For(jen.List(jen.Id("kind"), jen.Id("items")).Op(":=").Range().Id("includes")).Block(
+				jen.List(jen.Id("localized"), jen.Err()).Op(":=").Id("localizeItems").Call(jen.Id("items"), jen.Id("chain")),
+				jen.If(jen.Err().Op("!=").Nil()).Block(
+					jen.Return(jen.Nil(), jen.Err()),
+				),
+				jen.Id("includes").Index(jen.Id("kind")).Op("=").Id("localized"),
+			),
+			jen.List(jen.Id("localized"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("includes")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Id("data").Index(jen.Lit("includes")).Op("=").Id("localized"),
+		),
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("data"))),
+	)
+
+	f.Comment("decodeResponse reads and closes a response body and decodes it into v. Responses requested for a chain of several locales are localized first")
+	f.Func().Id("decodeResponse").Params(
+		jen.Id("resp").Op("*").Qual("net/http", "Response"),
+		jen.Id("chain").Index().String(),
+		jen.Id("v").Any(),
+	).Error().Block(
+		jen.List(jen.Id("body"), jen.Err()).Op(":=").Qual("io", "ReadAll").Call(jen.Id("resp.Body")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Return(jen.Err()),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(jen.Len(jen.Id("chain")).Op(">").Lit(1)).Block(
+			jen.If(
+				jen.List(jen.Id("body"), jen.Err()).Op("=").Id("localizeResponse").Call(jen.Id("body"), jen.Id("chain")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Err()),
+			),
+		),
+		jen.Return(jen.Qual("encoding/json", "Unmarshal").Call(jen.Id("body"), jen.Id("v"))),
+	)
+
+	f.Comment("newLocaleCache returns an iterator cache for responses in the given locale. Only caches for the client locale are backed by the client cache")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("newLocaleCache").Params(
+		jen.Id("locale").String(),
+	).Op("*").Id("iteratorCache").Block(
+		jen.Id("cache").Op(":=").Id("newIteratorCache").Call(),
+		jen.Id("cache.locale").Op("=").Id("locale"),
+		jen.If(jen.Id("locale").Op("==").Id("c.Locale")).Block(
+			jen.Id("cache.shared").Op("=").Id("c.cache"),
+		),
+		jen.Return(jen.Id("cache")),
+	)
+}
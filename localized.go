@@ -0,0 +1,275 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+// localizedFieldType returns the go type of a field of a localized model together with
+// the raw type it is decoded from and the function converting between both
+func localizedFieldType(f field) (typ, raw jen.Code, convert string, ok bool) {
+	switch f.Type {
+	case "Symbol", "Text":
+		return jen.String(), jen.String(), "identity", true
+	case "Integer":
+		return jen.Int64(), jen.Int64(), "identity", true
+	case "Number":
+		return jen.Float64(), jen.Float64(), "identity", true
+	case "Boolean":
+		return jen.Bool(), jen.Bool(), "identity", true
+	case "Date":
+		return jen.Id("Date"), jen.Id("Date"), "identity", true
+	case "Link":
+		return jen.String(), jen.Id("entryID"), "linkID", true
+	case "Array":
+		switch f.Items.Type {
+		case "Symbol", "Text":
+			return jen.Index().String(), jen.Index().String(), "identity", true
+		case "Link":
+			return jen.Index().String(), jen.Id("entryIDs"), "linkIDs", true
+		}
+	}
+	return nil, nil, "", false
+}
+
+func generateLocalizedTypes(f *jen.File) {
+	f.Comment("Localized holds the values of a localized field per locale code")
+	f.Type().Id("Localized").Types(jen.Id("T").Any()).Struct(
+		jen.Id("Values").Map(jen.String()).Id("T"),
+		jen.Id("fallbacks").Map(jen.String()).String(),
+	)
+
+	f.Comment("Get returns the value of a locale. Locales without a value fall back along the fallback chain known from Locales")
+	f.Func().Params(
+		jen.Id("l").Id("Localized").Types(jen.Id("T")),
+	).Id("Get").Params(
+		jen.Id("locale").String(),
+	).Params(
+		jen.Id("T"), jen.Bool(),
+	).Block(
+		jen.Id("seen").Op(":=").Map(jen.String()).Bool().Values(),
+		jen.For(jen.Id("locale").Op("!=").Lit("").Op("&&").Op("!").Id("seen").Index(jen.Id("locale"))).Block(
+			jen.If(
+				jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id("l.Values").Index(jen.Id("locale")),
+				jen.Id("ok"),
+			).Block(
+				jen.Return(jen.Id("v"), jen.True()),
+			),
+			jen.Id("seen").Index(jen.Id("locale")).Op("=").True(),
+			jen.Id("locale").Op("=").Id("l.fallbacks").Index(jen.Id("locale")),
+		),
+		jen.Var().Id("zero").Id("T"),
+		jen.Return(jen.Id("zero"), jen.False()),
+	)
+
+	f.Comment("localeFallbacks returns the fallback locale of every locale known from Locales")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("localeFallbacks").Params().Map(jen.String()).String().Block(
+		jen.Id("c.localesMu").Dot("RLock").Call(),
+		jen.Defer().Id("c.localesMu").Dot("RUnlock").Call(),
+		jen.Return(jen.Id("c.fallbacks")),
+	)
+
+	f.Comment("identity converts field values which are used as decoded")
+	f.Func().Id("identity").Types(jen.Id("T").Any()).Params(jen.Id("v").Id("T")).Id("T").Block(
+		jen.Return(jen.Id("v")),
+	)
+
+	f.Comment("linkID returns the ID of a linked entry or asset")
+	f.Func().Id("linkID").Params(jen.Id("id").Id("entryID")).String().Block(
+		jen.Return(jen.Id("id.Sys.ID")),
+	)
+
+	f.Comment("linkIDs returns the IDs of linked entries or assets")
+	f.Func().Id("linkIDs").Params(jen.Id("ids").Id("entryIDs")).Index().String().Block(
+		jen.Id("values").Op(":=").Make(jen.Index().String(), jen.Len(jen.Id("ids"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+			jen.Id("values").Index(jen.Id("i")).Op("=").Id("id.Sys.ID"),
+		),
+		jen.Return(jen.Id("values")),
+	)
+
+	f.Comment("localizedValue decodes the values of a localized field of a multi locale entry")
+	f.Func().Id("localizedValue").Types(jen.List(jen.Id("T"), jen.Id("U")).Any()).Params(
+		jen.Id("values").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.Id("fallbacks").Map(jen.String()).String(),
+		jen.Id("convert").Func().Params(jen.Id("T")).Id("U"),
+	).Params(
+		jen.Id("Localized").Types(jen.Id("U")), jen.Error(),
+	).Block(
+		jen.Id("l").Op(":=").Id("Localized").Types(jen.Id("U")).Values(jen.Dict{
+			jen.Id("Values"):    jen.Make(jen.Map(jen.String()).Id("U"), jen.Len(jen.Id("values"))),
+			jen.Id("fallbacks"): jen.Id("fallbacks"),
+		}),
+		jen.For(jen.List(jen.Id("locale"), jen.Id("raw")).Op(":=").Range().Id("values")).Block(
+			jen.If(jen.String().Call(jen.Id("raw")).Op("==").Lit("null")).Block(
+				jen.Continue(),
+			),
+			jen.Var().Id("v").Id("T"),
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("raw"), jen.Op("&").Id("v")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Id("l"), jen.Err()),
+			),
+			jen.Id("l.Values").Index(jen.Id("locale")).Op("=").Id("convert").Call(jen.Id("v")),
+		),
+		jen.Return(jen.Id("l"), jen.Nil()),
+	)
+
+	f.Comment("unlocalizedValue decodes the single value of a field of a multi locale entry which is not localized")
+	f.Func().Id("unlocalizedValue").Types(jen.List(jen.Id("T"), jen.Id("U")).Any()).Params(
+		jen.Id("values").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.Id("convert").Func().Params(jen.Id("T")).Id("U"),
+	).Params(
+		jen.Id("U"), jen.Error(),
+	).Block(
+		jen.Var().Id("v").Id("T"),
+		jen.For(jen.List(jen.Id("_"), jen.Id("raw")).Op(":=").Range().Id("values")).Block(
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("raw"), jen.Op("&").Id("v")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Var().Id("zero").Id("U"),
+				jen.Return(jen.Id("zero"), jen.Err()),
+			),
+			jen.Break(),
+		),
+		jen.Return(jen.Id("convert").Call(jen.Id("v")), jen.Nil()),
+	)
+
+	for _, m := range models {
+		generateLocalizedModel(f, m)
+	}
+}
+
+func generateLocalizedModel(f *jen.File, m contentfulModel) {
+	name := fmt.Sprintf("Localized%s", m.Name)
+	iterator := fmt.Sprintf("%sIterator", name)
+	plural := inflector.Pluralize(m.Name)
+
+	f.Commentf("%s holds the values of all locales of a %s. Links are represented by the IDs of the linked entries and assets", name, m.Name)
+	f.Type().Id(name).StructFunc(func(g *jen.Group) {
+		g.Id("ID").String()
+		for _, field := range m.Fields {
+			typ, _, _, ok := localizedFieldType(field)
+			if !ok {
+				continue
+			}
+			if field.Localized {
+				g.Id(fieldName(field)).Id("Localized").Types(typ)
+			} else {
+				g.Id(fieldName(field)).Add(typ)
+			}
+		}
+	})
+
+	f.Commentf("decode%s converts a raw multi locale %s entry", name, m.Name)
+	f.Func().Id(fmt.Sprintf("decode%s", name)).Params(
+		jen.Id("raw").Id("includeEntry"),
+		jen.Id("fallbacks").Map(jen.String()).String(),
+	).Params(
+		jen.Op("*").Id(name), jen.Error(),
+	).BlockFunc(func(g *jen.Group) {
+		g.Var().Id("fields").Map(jen.String()).Map(jen.String()).Qual("encoding/json", "RawMessage")
+		g.If(jen.Id("raw.Fields").Op("!=").Nil()).Block(
+			jen.If(
+				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Op("*").Id("raw.Fields"), jen.Op("&").Id("fields")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+		)
+		g.Id("item").Op(":=").Op("&").Id(name).Values(jen.Dict{
+			jen.Id("ID"): jen.Id("raw.Sys.ID"),
+		})
+		g.Var().Err().Error()
+		for _, field := range m.Fields {
+			_, raw, convert, ok := localizedFieldType(field)
+			if !ok {
+				continue
+			}
+			convertFunc := jen.Id(convert)
+			if convert == "identity" {
+				convertFunc = jen.Id("identity").Types(raw)
+			}
+			value := jen.Id("unlocalizedValue").Call(jen.Id("fields").Index(jen.Lit(field.Name)), convertFunc)
+			if field.Localized {
+				value = jen.Id("localizedValue").Call(jen.Id("fields").Index(jen.Lit(field.Name)), jen.Id("fallbacks"), convertFunc)
+			}
+			g.If(
+				jen.List(jen.Id("item").Dot(fieldName(field)), jen.Err()).Op("=").Add(value),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			)
+		}
+		g.Return(jen.Id("item"), jen.Nil())
+	})
+
+	f.Commentf("%s is used to paginate result sets of %s", iterator, name)
+	f.Type().Id(iterator).Struct(
+		append(
+			iteratorFields(),
+			jen.Id("c").Op("*").Id("ContentClient"),
+			jen.Id("items").Index().Op("*").Id(name),
+			jen.Id("query").String(),
+		)...,
+	)
+
+	generateIteratorMethods(f, iterator, name)
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("fetch").Params().Id("error").Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("it.c").Dot(fmt.Sprintf("request%s", plural)).Call(
+			jen.Lit("*"),
+			jen.Qual("fmt", "Sprintf").Call(jen.Lit("include=0&limit=%d&skip=%d&%s"), jen.Id("it.Limit"), jen.Id("it.Offset"), jen.Id("it.query")),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("fallbacks").Op(":=").Id("it.c").Dot("localeFallbacks").Call(),
+		jen.Id("items").Op(":=").Make(jen.Index().Op("*").Id(name), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
+			jen.If(
+				jen.List(jen.Id("items").Index(jen.Id("i")), jen.Err()).Op("=").Id(fmt.Sprintf("decode%s", name)).Call(jen.Id("raw"), jen.Id("fallbacks")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Err()),
+			),
+		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Commentf("Localized%s retrieves paginated %s entries with the values of all locales. Call Locales first for Get to apply fallbacks", plural, m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("Localized%s", plural)).Params(
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id(iterator).Block(
+		jen.Return(jen.Id("c").Dot(fmt.Sprintf("QueryLocalized%s", plural)).Call(jen.Id(fmt.Sprintf("%sQuery", m.Name)).Call(), jen.Id("opts"))),
+	)
+
+	f.Commentf("QueryLocalized%s retrieves paginated %s entries matching the given query with the values of all locales", plural, m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("QueryLocalized%s", plural)).Params(
+		jen.Id("q").Op("*").Id(fmt.Sprintf("%sQueryBuilder", m.Name)),
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id(iterator).Block(
+		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
+			jen.Id("opts.Limit").Op("=").Lit(100),
+		),
+		jen.Return(jen.Op("&").Id(iterator).Values(jen.Dict{
+			jen.Id("Limit"):  jen.Id("opts.Limit"),
+			jen.Id("Offset"): jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("c"):      jen.Id("c"),
+			jen.Id("query"):  jen.Id("q").Dot("encode").Call(),
+		})),
+	)
+}
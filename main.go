@@ -13,8 +13,11 @@ import (
 	"github.com/dave/jennifer/jen"
 )
 
+// validation, field and contentfulModel decode the schema. Their public counterparts
+// Validation, Field and ContentType are generated by generateManagementContentTypes
 type validation struct {
 	LinkContentType []string `json:"linkContentType"`
+	Unique          bool     `json:"unique"`
 }
 
 type field struct {
@@ -42,6 +45,15 @@ type contentfulModel struct {
 	}
 }
 
+func (f field) Unique() bool {
+	for _, v := range f.Validations {
+		if v.Unique {
+			return true
+		}
+	}
+	return false
+}
+
 func (m contentfulModel) CapitalizedName() string {
 	return strings.ToUpper(m.Name[0:1]) + m.Name[1:]
 }
@@ -66,6 +78,7 @@ var (
 const cdaEndpoint = "cdn.contentful.com"
 const cmaEndpoint = "api.contentful.com"
 const cpaEndpoint = "preview.contentful.com"
+const uploadEndpoint = "upload.contentful.com"
 
 func init() {
 	var url = fmt.Sprintf("https://%s/spaces/%s/content_types?access_token=%s", cmaEndpoint, os.Getenv("CONTENTFUL_SPACE_ID"), os.Getenv("CONTENTFUL_AUTH_TOKEN"))
@@ -107,14 +120,30 @@ func main() {
 	generateDateType(f)
 	generateAssetType(f)
 	generateResponseTypes(f)
+	generateLinkTypes(f)
 	generateIteratorCacheType(f)
+	generateQueryUtils(f)
 	for _, model := range models {
 		generateModelType(f, model)
+		generateQueryBuilder(f, model)
+		generateLinkInterfaces(f, model)
 	}
 
 	generateIteratorUtils(f)
 	generateContentClient(f)
+	generateLinkFetcher(f)
+	generateCache(f)
+	generateWebhookHandler(f)
+	generateLocales(f)
+	generateLocalizedTypes(f)
+	generateSync(f)
+	generateStore(f)
+	generateAssetClient(f)
 	generateManagementClient(f)
+	generateManagementEntries(f)
+	generatePatchUtils(f)
+	generateManagementAssets(f)
+	generateManagementContentTypes(f)
 
 	file, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0755)
 	if err != nil {
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/dave/jennifer/jen"
@@ -11,6 +10,7 @@ func generateManagementClient(f *jen.File) {
 	f.Comment("ManagementClient implements a space specific contentful client")
 	f.Type().Id("ManagementClient").Struct(
 		jen.Id("host").String(),
+		jen.Id("uploadHost").String(),
 		jen.Id("spaceID").String(),
 		jen.Id("authToken").String(),
 		jen.Id("client").Op("*").Qual("net/http", "Client"),
@@ -24,10 +24,11 @@ func generateManagementClient(f *jen.File) {
 		jen.Id("pool").Op(":=").Qual("crypto/x509", "NewCertPool").Call(),
 		jen.Id("pool").Dot("AppendCertsFromPEM").Call(jen.Index().Byte().Parens(jen.Lit(certs))),
 		jen.Return(jen.Op("&").Id("ManagementClient").Values(jen.Dict{
-			jen.Id("host"):      jen.Qual("fmt", "Sprintf").Params(jen.Lit("https://%s"), jen.Id("contentfulCPAURL")),
-			jen.Id("spaceID"):   jen.Lit(os.Getenv("CONTENTFUL_SPACE_ID")),
-			jen.Id("authToken"): jen.Id("authToken"),
-			jen.Id("pool"):      jen.Id("pool"),
+			jen.Id("host"):       jen.Qual("fmt", "Sprintf").Params(jen.Lit("https://%s"), jen.Id("contentfulCMAURL")),
+			jen.Id("uploadHost"): jen.Qual("fmt", "Sprintf").Params(jen.Lit("https://%s"), jen.Id("contentfulUploadURL")),
+			jen.Id("spaceID"):    jen.Lit(os.Getenv("CONTENTFUL_SPACE_ID")),
+			jen.Id("authToken"):  jen.Id("authToken"),
+			jen.Id("pool"):       jen.Id("pool"),
 			jen.Id("client"): jen.Op("&").Qual("net/http", "Client").Values(jen.Dict{
 				jen.Id("Transport"): jen.Op("&").Qual("net/http", "Transport").Values(jen.Dict{
 					jen.Id("TLSClientConfig"): jen.Op("&").Qual("crypto/tls", "Config").Values(jen.Dict{
@@ -38,6 +39,131 @@ func generateManagementClient(f *jen.File) {
 		})),
 	)
 
+	f.Comment("do sends a management api request with in as json payload unless it is nil and decodes the response into out unless it is nil")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ManagementClient"),
+	).Id("do").Params(
+		jen.List(jen.Id("method"), jen.Id("path")).String(),
+		jen.Id("header").Qual("net/http", "Header"),
+		jen.List(jen.Id("in"), jen.Id("out")).Interface(),
+	).Error().Block(
+		jen.Var().Id("body").Qual("io", "Reader"),
+		jen.If(jen.Id("in").Op("!=").Nil()).Block(
+			jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("in")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			),
+			jen.Id("body").Op("=").Qual("bytes", "NewReader").Call(jen.Id("b")),
+		),
+		jen.List(jen.Id("req"), jen.Err()).Op(":=").Qual("net/http", "NewRequest").Call(
+			jen.Id("method"),
+			jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s/spaces/%s%s"), jen.Id("c.host"), jen.Id("c.spaceID"), jen.Id("path")),
+			jen.Id("body"),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.For(jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Id("header")).Block(
+			jen.Id("req.Header").Index(jen.Id("k")).Op("=").Id("v"),
+		),
+		jen.Id("req").Dot("Header").Dot("Set").Call(
+			jen.Lit("Authorization"),
+			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("c.authToken")),
+		),
+		jen.If(jen.Id("req.Header").Dot("Get").Call(jen.Lit("Content-Type")).Op("==").Lit("")).Block(
+			jen.Id("req").Dot("Header").Dot("Set").Call(
+				jen.Lit("Content-Type"),
+				jen.Lit("application/vnd.contentful.management.v1+json"),
+			),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.client.Do").Call(jen.Id("req")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(jen.Id("resp.StatusCode").Op("<").Lit(200).Op("||").Id("resp.StatusCode").Op(">=").Lit(300)).Block(
+			jen.Return(jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.If(jen.Id("out").Op("==").Nil().Op("||").Id("resp.StatusCode").Op("==").Qual("net/http", "StatusNoContent")).Block(
+			jen.Return(jen.Id("resp.Body").Dot("Close").Call()),
+		),
+		jen.Return(jen.Id("decodeResponse").Call(jen.Id("resp"), jen.Nil(), jen.Id("out"))),
+	)
+
+	f.Comment("versionHeader returns the header sending the version of a resource to update")
+	f.Func().Id("versionHeader").Params(
+		jen.Id("version").Int(),
+	).Qual("net/http", "Header").Block(
+		jen.Return(jen.Qual("net/http", "Header").Values(jen.Dict{
+			jen.Lit("X-Contentful-Version"): jen.Index().String().Values(jen.Qual("strconv", "Itoa").Call(jen.Id("version"))),
+		})),
+	)
+
+	f.Comment("maxUpdateAttempts bounds the attempts of UpdateWithRetry")
+	f.Const().Id("maxUpdateAttempts").Op("=").Lit(5)
+
+	f.Comment("updateWithRetry fetches a resource, applies fn and updates it until the update succeeds without a version conflict or maxUpdateAttempts is reached")
+	f.Func().Id("updateWithRetry").Types(jen.Id("T").Any()).Params(
+		jen.Id("get").Func().Params().Params(jen.Op("*").Id("T"), jen.Error()),
+		jen.Id("update").Func().Params(jen.Op("*").Id("T")).Error(),
+		jen.Id("fn").Func().Params(jen.Op("*").Id("T")).Error(),
+	).Params(
+		jen.Op("*").Id("T"), jen.Error(),
+	).Block(
+		jen.For(jen.Id("attempt").Op(":=").Lit(1), jen.Empty(), jen.Id("attempt").Op("++")).Block(
+			jen.List(jen.Id("v"), jen.Err()).Op(":=").Id("get").Call(),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.If(
+				jen.Err().Op(":=").Id("fn").Call(jen.Id("v")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.Err().Op("=").Id("update").Call(jen.Id("v")),
+			jen.If(jen.Err().Op("==").Nil()).Block(
+				jen.Return(jen.Id("v"), jen.Nil()),
+			),
+			jen.If(jen.Op("!").Qual("errors", "Is").Call(jen.Err(), jen.Id("ErrVersionMismatch")).Op("||").Id("attempt").Op(">=").Id("maxUpdateAttempts")).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+		),
+	)
+
+	f.Comment("Link references an entry or asset in management api payloads")
+	f.Type().Id("Link").Struct(
+		jen.Id("Sys").Id("LinkSys").Tag(map[string]string{"json": "sys"}),
+	)
+
+	f.Comment("LinkSys holds the target of a Link")
+	f.Type().Id("LinkSys").Struct(
+		jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+		jen.Id("LinkType").String().Tag(map[string]string{"json": "linkType"}),
+		jen.Id("ID").String().Tag(map[string]string{"json": "id"}),
+	)
+
+	f.Comment("EntryLink returns a link to the entry with the given ID")
+	f.Func().Id("EntryLink").Params(jen.Id("id").String()).Id("Link").Block(
+		jen.Return(jen.Id("Link").Values(jen.Dict{
+			jen.Id("Sys"): jen.Id("LinkSys").Values(jen.Dict{
+				jen.Id("Type"):     jen.Lit("Link"),
+				jen.Id("LinkType"): jen.Lit("Entry"),
+				jen.Id("ID"):       jen.Id("id"),
+			}),
+		})),
+	)
+
+	f.Comment("AssetLink returns a link to the asset with the given ID")
+	f.Func().Id("AssetLink").Params(jen.Id("id").String()).Id("Link").Block(
+		jen.Return(jen.Id("Link").Values(jen.Dict{
+			jen.Id("Sys"): jen.Id("LinkSys").Values(jen.Dict{
+				jen.Id("Type"):     jen.Lit("Link"),
+				jen.Id("LinkType"): jen.Lit("Asset"),
+				jen.Id("ID"):       jen.Id("id"),
+			}),
+		})),
+	)
+
 	f.Comment("Webhook describes a webhook definition")
 	f.Type().Id("Webhook").Struct(
 		jen.Id("ID").String().Tag(map[string]string{"json": "-"}),
@@ -49,11 +175,11 @@ func generateManagementClient(f *jen.File) {
 
 	f.Comment("WebhookIterator is used to paginate webhooks")
 	f.Type().Id("WebhookIterator").Struct(
-		jen.Id("Page").Int(),
-		jen.Id("Limit").Int(),
-		jen.Id("Offset").Int(),
-		jen.Id("c").Op("*").Id("ManagementClient"),
-		jen.Id("items").Index().Id("Webhook"),
+		append(
+			iteratorFields(),
+			jen.Id("c").Op("*").Id("ManagementClient"),
+			jen.Id("items").Index().Op("*").Id("Webhook"),
+		)...,
 	)
 
 	f.Type().Id("webhookItem").Struct(
@@ -68,96 +194,26 @@ func generateManagementClient(f *jen.File) {
 		jen.Id("Items").Index().Id("webhookItem").Tag(map[string]string{"json": "items"}),
 	)
 
-	f.Comment("Next returns the following item of type Webhook. If none exists a network request will be executed")
-	f.Func().Params(
-		jen.Id("it").Op("*").Id("WebhookIterator"),
-	).Id("Next").Params().Params(
-		jen.Op("*").Id("Webhook"), jen.Id("error"),
-	).Block(
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.If(
-				jen.Err().Op(":=").Id("it.fetch").Call(),
-				jen.Err().Op("!=").Nil(),
-			).Block(
-				jen.Return(jen.Nil(), jen.Err()),
-			),
-		),
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.Return(jen.Nil(), jen.Id("ErrIteratorDone")),
-		),
-		jen.Var().Id("item").Id("Webhook"),
-		jen.List(
-			jen.Id("item"),
-			jen.Id("it.items"),
-		).Op("=").List(
-			jen.Id("it.items").Index(jen.Len(jen.Id("it.items")).Op("-").Lit(1)),
-			jen.Id("it.items").Index(jen.Empty(), jen.Len(jen.Id("it.items")).Op("-").Lit(1)),
-		),
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.Id("it.Page").Op("++"),
-			jen.Id("it.Offset").Op("=").Id("it.Page").Op("*").Id("it.Limit"),
-		),
-		jen.Return(jen.Id("&item, nil")),
-	)
+	generateIteratorMethods(f, "WebhookIterator", "Webhook")
 
 	f.Func().Params(
 		jen.Id("it").Op("*").Id("WebhookIterator"),
 	).Id("fetch").Params().Id("error").Block(
-		jen.Id("c").Op(":=").Id("it.c"),
-		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
-			jen.Lit(fmt.Sprintf("https://%s/spaces/%%s/webhook_definitions?limit=%%d&skip=%%d", cmaEndpoint)),
-			jen.Id("c.spaceID"),
-			jen.Id("it.Limit"),
-			jen.Id("it.Offset"),
-		),
-		jen.Var().List(jen.Id("req"), jen.Id("err")).Op("=").Qual("net/http", "NewRequest").Call(
-			jen.Lit("GET"),
-			jen.Id("url"),
-			jen.Nil(),
-		),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Authorization"),
-			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("c.authToken")),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Content-Type"),
-			jen.Lit("application/vnd.contentful.management.v1+json"),
-		),
-		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.client.Do").Call(jen.Id("req")),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
-			jen.Return(
-				jen.Qual("fmt", "Errorf").Call(
-					jen.Lit("Request failed: %s, %v"),
-					jen.Id("resp.Status"),
-					jen.Err(),
-				),
-			),
-		),
 		jen.Var().Id("data").Id("webhooksResponse"),
 		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(
-				jen.Id("resp.Body"),
-			).Dot("Decode").Call(jen.Op("&").Id("data")),
-			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.If(
-			jen.Err().Op(":=").Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Err().Op(":=").Id("it.c").Dot("do").Call(jen.Lit("GET"), jen.Qual("fmt", "Sprintf").Call(jen.Lit("/webhook_definitions?limit=%d&skip=%d"), jen.Id("it.Limit"), jen.Id("it.Offset")), jen.Nil(), jen.Nil(), jen.Op("&").Id("data")),
 			jen.Err().Op("!=").Nil(),
 		).Block(
 			jen.Return(jen.Err()),
 		),
-		jen.Id("it.items").Op("=").Index().Id("Webhook").Values(),
-		jen.For(jen.List(jen.Id("_"), jen.Id("i")).Op(":=").Range().Id("data.Items")).Block(
+		jen.Var().Id("items").Op("=").Make(jen.Index().Op("*").Id("Webhook"), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("j"), jen.Id("i")).Op(":=").Range().Id("data.Items")).Block(
 			jen.Id("i").Dot("Webhook").Dot("ID").Op("=").Id("i").Dot("Sys").Dot("ID"),
 			jen.Id("i").Dot("Webhook").Dot("Version").Op("=").Id("i").Dot("Sys").Dot("Version"),
-			jen.Id("it").Dot("items").Op("=").Append(jen.Id("it").Dot("items"), jen.Id("i").Dot("Webhook")),
+			jen.Id("webhook").Op(":=").Id("i").Dot("Webhook"),
+			jen.Id("items").Index(jen.Id("j")).Op("=").Op("&").Id("webhook"),
 		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
 		jen.Return(jen.Nil()),
 	)
 
@@ -172,9 +228,9 @@ func generateManagementClient(f *jen.File) {
 		),
 
 		jen.Id("it").Op(":=").Op("&").Id("WebhookIterator").Values(jen.Dict{
-			jen.Id("Page"):  jen.Id("opts.Page"),
-			jen.Id("Limit"): jen.Id("opts.Limit"),
-			jen.Id("c"):     jen.Id("ws").Dot("client"),
+			jen.Id("Limit"):  jen.Id("opts.Limit"),
+			jen.Id("Offset"): jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("c"):      jen.Id("ws").Dot("client"),
 		}),
 		jen.Return(jen.Id("it")),
 	)
@@ -183,55 +239,13 @@ func generateManagementClient(f *jen.File) {
 	f.Func().Params(
 		jen.Id("ws").Op("*").Id("WebhookService"),
 	).Id("Create").Params(jen.Id("w").Op("*").Id("Webhook")).Params(jen.Id("error")).Block(
-		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
-			jen.Lit(fmt.Sprintf("https://%s/spaces/%%s/webhook_definitions", cmaEndpoint)),
-			jen.Id("ws.client.spaceID"),
-		),
-		jen.Id("b").Op(":=").Qual("bytes", "Buffer").Values(),
 		jen.Var().Id("payload").Op("=").Id("webhookItem").Values(
 			jen.Dict{
 				jen.Id("Webhook"): jen.Op("*").Id("w"),
 			},
 		),
 		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewEncoder").Call(jen.Id("&b")).Dot("Encode").Call(jen.Id("payload")),
-			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.Var().List(jen.Id("req"), jen.Id("err")).Op("=").Qual("net/http", "NewRequest").Call(
-			jen.Lit("POST"),
-			jen.Id("url"),
-			jen.Op("&").Id("b"),
-		),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Authorization"),
-			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("ws.client.authToken")),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Content-Type"),
-			jen.Lit("application/vnd.contentful.management.v1+json"),
-		),
-		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("ws.client.client.Do").Call(jen.Id("req")),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusCreated")).Block(
-			jen.Return(
-				jen.Qual("fmt", "Errorf").Call(
-					jen.Lit("Request failed: %s, %v"),
-					jen.Id("resp.Status"),
-					jen.Err(),
-				),
-			),
-		),
-		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("resp.Body")).Dot("Decode").Call(jen.Id("&payload")),
-			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.If(
-			jen.Err().Op(":=").Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Err().Op(":=").Id("ws.client").Dot("do").Call(jen.Lit("POST"), jen.Lit("/webhook_definitions"), jen.Nil(), jen.Id("payload.Webhook"), jen.Op("&").Id("payload")),
 			jen.Err().Op("!=").Nil(),
 		).Block(
 			jen.Return(jen.Err()),
@@ -245,107 +259,60 @@ func generateManagementClient(f *jen.File) {
 	f.Func().Params(
 		jen.Id("ws").Op("*").Id("WebhookService"),
 	).Id("Update").Params(jen.Id("w").Op("*").Id("Webhook")).Params(jen.Id("error")).Block(
-		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
-			jen.Lit(fmt.Sprintf("https://%s/spaces/%%s/webhook_definitions/%%s", cmaEndpoint)),
-			jen.Id("ws.client.spaceID"),
-			jen.Id("w.ID"),
-		),
-		jen.Id("b").Op(":=").Qual("bytes", "Buffer").Values(),
 		jen.Var().Id("payload").Op("=").Id("webhookItem").Values(
 			jen.Dict{
 				jen.Id("Webhook"): jen.Op("*").Id("w"),
 			},
 		),
 		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewEncoder").Call(jen.Id("&b")).Dot("Encode").Call(jen.Id("payload")),
+			jen.Err().Op(":=").Id("ws.client").Dot("do").Call(jen.Lit("PUT"), jen.Lit("/webhook_definitions/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("w.ID")), jen.Id("versionHeader").Call(jen.Id("w.Version")), jen.Id("payload.Webhook"), jen.Op("&").Id("payload")),
 			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.Var().List(jen.Id("req"), jen.Id("err")).Op("=").Qual("net/http", "NewRequest").Call(
-			jen.Lit("PUT"),
-			jen.Id("url"),
-			jen.Op("&").Id("b"),
-		),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Authorization"),
-			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("ws.client.authToken")),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("X-Contentful-Version"),
-			jen.Qual("fmt", "Sprintf").Call(jen.Lit("%d"), jen.Id("w.Version")),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Content-Type"),
-			jen.Lit("application/vnd.contentful.management.v1+json"),
-		),
-		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("ws.client.client.Do").Call(jen.Id("req")),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
+		).Block(
 			jen.Return(jen.Err()),
 		),
-		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
-			jen.Return(
-				jen.Qual("fmt", "Errorf").Call(
-					jen.Lit("Request failed: %s, %v"),
-					jen.Id("resp.Status"),
-					jen.Err(),
-				),
-			),
-		),
-		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(jen.Id("resp.Body")).Dot("Decode").Call(jen.Id("&payload")),
-			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
+		jen.Id("*w").Op("=").Id("payload.Webhook"),
+		jen.Id("w.ID").Op("=").Id("payload.Sys.ID"),
+		jen.Id("w.Version").Op("=").Id("payload.Sys.Version"),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("Get retrieves a single webhook definition by its ID")
+	f.Func().Params(
+		jen.Id("ws").Op("*").Id("WebhookService"),
+	).Id("Get").Params(jen.Id("id").String()).Params(jen.Op("*").Id("Webhook"), jen.Id("error")).Block(
+		jen.Var().Id("payload").Id("webhookItem"),
 		jen.If(
-			jen.Err().Op(":=").Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Err().Op(":=").Id("ws.client").Dot("do").Call(jen.Lit("GET"), jen.Lit("/webhook_definitions/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("id")), jen.Nil(), jen.Nil(), jen.Op("&").Id("payload")),
 			jen.Err().Op("!=").Nil(),
 		).Block(
-			jen.Return(jen.Err()),
+			jen.Return(jen.Nil(), jen.Err()),
 		),
-		jen.Id("*w").Op("=").Id("payload.Webhook"),
-		jen.Return(jen.Nil()),
+		jen.Id("payload.Webhook.ID").Op("=").Id("payload.Sys.ID"),
+		jen.Id("payload.Webhook.Version").Op("=").Id("payload.Sys.Version"),
+		jen.Return(jen.Op("&").Id("payload.Webhook"), jen.Nil()),
+	)
+
+	f.Comment("UpdateWithRetry applies fn to the latest version of a webhook definition and updates it, retrying with a fresh version on conflicts")
+	f.Func().Params(
+		jen.Id("ws").Op("*").Id("WebhookService"),
+	).Id("UpdateWithRetry").Params(
+		jen.Id("id").String(),
+		jen.Id("fn").Func().Params(jen.Op("*").Id("Webhook")).Error(),
+	).Params(jen.Op("*").Id("Webhook"), jen.Id("error")).Block(
+		jen.Return(jen.Id("updateWithRetry").Call(
+			jen.Func().Params().Params(jen.Op("*").Id("Webhook"), jen.Error()).Block(
+				jen.Return(jen.Id("ws").Dot("Get").Call(jen.Id("id"))),
+			),
+			jen.Id("ws.Update"),
+			jen.Id("fn"),
+		)),
 	)
 
 	f.Comment("Delete adds a new webhook definitions")
 	f.Func().Params(
 		jen.Id("ws").Op("*").Id("WebhookService"),
 	).Id("Delete").Params(jen.Id("id").String()).Params(jen.Id("error")).Block(
-		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
-			jen.Lit(fmt.Sprintf("https://%s/spaces/%%s/webhook_definitions/%%s", cmaEndpoint)),
-			jen.Id("ws.client.spaceID"),
-			jen.Id("id"),
-		),
-		jen.Var().List(jen.Id("req"), jen.Id("err")).Op("=").Qual("net/http", "NewRequest").Call(
-			jen.Lit("DELETE"),
-			jen.Id("url"),
-			jen.Nil(),
-		),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Authorization"),
-			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("ws.client.authToken")),
-		),
-		jen.Id("req").Dot("Header").Dot("Set").Call(
-			jen.Lit("Content-Type"),
-			jen.Lit("application/vnd.contentful.management.v1+json"),
-		),
-		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("ws.client.client.Do").Call(jen.Id("req")),
-		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
-		),
-		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusNoContent")).Block(
-			jen.Return(
-				jen.Qual("fmt", "Errorf").Call(
-					jen.Lit("Request failed: %s, %v"),
-					jen.Id("resp.Status"),
-					jen.Err(),
-				),
-			),
-		),
-		jen.Return(jen.Id("resp").Dot("Body").Dot("Close").Call()),
+		jen.Return(jen.Id("ws.client").Dot("do").Call(jen.Lit("DELETE"), jen.Lit("/webhook_definitions/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("id")), jen.Nil(), jen.Nil(), jen.Nil())),
 	)
 
 	f.Comment("WebhookService includes webhook management functions")
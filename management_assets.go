@@ -0,0 +1,406 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+func generateManagementAssets(f *jen.File) {
+	f.Comment("ErrProcessingTimeout is returned when asset files are not processed in time")
+	f.Var().Id("ErrProcessingTimeout").Op("=").Qual("errors", "New").Call(jen.Lit("asset processing timed out"))
+
+	f.Comment("assetPollInterval is the delay between checks of WaitProcessed")
+	f.Const().Id("assetPollInterval").Op("=").Qual("time", "Second")
+
+	f.Comment("Upload is a file uploaded to the upload api which can be attached to assets until it expires")
+	f.Type().Id("Upload").Struct(
+		jen.Id("ID").String(),
+		jen.Id("ExpiresAt").Qual("time", "Time"),
+	)
+
+	f.Type().Id("uploadItem").Struct(
+		jen.Id("Sys").Struct(
+			jen.Id("ID").String().Tag(map[string]string{"json": "id"}),
+			jen.Id("ExpiresAt").Qual("time", "Time").Tag(map[string]string{"json": "expiresAt"}),
+		).Tag(map[string]string{"json": "sys"}),
+	)
+
+	f.Comment("UploadLink returns a link to the upload with the given ID")
+	f.Func().Id("UploadLink").Params(jen.Id("id").String()).Id("Link").Block(
+		jen.Return(jen.Id("Link").Values(jen.Dict{
+			jen.Id("Sys"): jen.Id("LinkSys").Values(jen.Dict{
+				jen.Id("Type"):     jen.Lit("Link"),
+				jen.Id("LinkType"): jen.Lit("Upload"),
+				jen.Id("ID"):       jen.Id("id"),
+			}),
+		})),
+	)
+
+	f.Comment("AssetFile is a single locale file of an asset. URL is set once the file is processed")
+	f.Type().Id("AssetFile").Struct(
+		jen.Id("FileName").String().Tag(map[string]string{"json": "fileName"}),
+		jen.Id("ContentType").String().Tag(map[string]string{"json": "contentType"}),
+		jen.Id("Upload").String().Tag(map[string]string{"json": "upload,omitempty"}),
+		jen.Id("UploadFrom").Op("*").Id("Link").Tag(map[string]string{"json": "uploadFrom,omitempty"}),
+		jen.Id("URL").String().Tag(map[string]string{"json": "url,omitempty"}),
+	)
+
+	f.Comment("UploadedFile returns an asset file created from an upload")
+	f.Func().Id("UploadedFile").Params(
+		jen.List(jen.Id("uploadID"), jen.Id("fileName"), jen.Id("contentType")).String(),
+	).Id("AssetFile").Block(
+		jen.Id("link").Op(":=").Id("UploadLink").Call(jen.Id("uploadID")),
+		jen.Return(jen.Id("AssetFile").Values(jen.Dict{
+			jen.Id("FileName"):    jen.Id("fileName"),
+			jen.Id("ContentType"): jen.Id("contentType"),
+			jen.Id("UploadFrom"):  jen.Op("&").Id("link"),
+		})),
+	)
+
+	f.Comment("RemoteFile returns an asset file which contentful fetches from url during processing")
+	f.Func().Id("RemoteFile").Params(
+		jen.List(jen.Id("url"), jen.Id("fileName"), jen.Id("contentType")).String(),
+	).Id("AssetFile").Block(
+		jen.Return(jen.Id("AssetFile").Values(jen.Dict{
+			jen.Id("FileName"):    jen.Id("fileName"),
+			jen.Id("ContentType"): jen.Id("contentType"),
+			jen.Id("Upload"):      jen.Id("url"),
+		})),
+	)
+
+	f.Comment("ManagedAssetFields holds the asset fields in the management api format, mapping locale codes to values")
+	f.Type().Id("ManagedAssetFields").Struct(
+		jen.Id("Title").Map(jen.String()).String().Tag(map[string]string{"json": "title,omitempty"}),
+		jen.Id("Description").Map(jen.String()).String().Tag(map[string]string{"json": "description,omitempty"}),
+		jen.Id("File").Map(jen.String()).Id("AssetFile").Tag(map[string]string{"json": "file,omitempty"}),
+	)
+
+	f.Comment("ManagedAsset is an asset of the management api")
+	f.Type().Id("ManagedAsset").Struct(
+		jen.Id("ID").String().Tag(map[string]string{"json": "-"}),
+		jen.Id("Version").Int().Tag(map[string]string{"json": "-"}),
+		jen.Id("Fields").Id("ManagedAssetFields").Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Type().Id("managedAssetItem").Struct(
+		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
+		jen.Id("Fields").Id("ManagedAssetFields").Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Comment("processed reports whether the files of the given locales have been processed. All files are checked if no locale is given")
+	f.Func().Params(
+		jen.Id("a").Op("*").Id("ManagedAsset"),
+	).Id("processed").Params(
+		jen.Id("locales").Index().String(),
+	).Bool().Block(
+		jen.If(jen.Len(jen.Id("locales")).Op("==").Lit(0)).Block(
+			jen.For(jen.Id("locale").Op(":=").Range().Id("a.Fields.File")).Block(
+				jen.Id("locales").Op("=").Append(jen.Id("locales"), jen.Id("locale")),
+			),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("locale")).Op(":=").Range().Id("locales")).Block(
+			jen.If(jen.Id("a.Fields.File").Index(jen.Id("locale")).Dot("URL").Op("==").Lit("")).Block(
+				jen.Return(jen.False()),
+			),
+		),
+		jen.Return(jen.True()),
+	)
+
+	f.Comment("AssetService includes asset and upload management functions")
+	f.Type().Id("AssetService").Struct(
+		jen.Id("client").Op("*").Id("ManagementClient"),
+	)
+
+	f.Comment("Assets returns an asset management service")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ManagementClient"),
+	).Id("Assets").Params().Op("*").Id("AssetService").Block(
+		jen.Return(jen.Op("&").Id("AssetService").Values(jen.Dict{
+			jen.Id("client"): jen.Id("c"),
+		})),
+	)
+
+	f.Comment("Upload sends the content of r to the upload api. Readers of unknown length, like files, are streamed using chunked transfer encoding")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Upload").Params(
+		jen.Id("r").Qual("io", "Reader"),
+	).Params(
+		jen.Op("*").Id("Upload"), jen.Error(),
+	).Block(
+		jen.List(jen.Id("req"), jen.Err()).Op(":=").Qual("net/http", "NewRequest").Call(
+			jen.Lit("POST"),
+			jen.Qual("fmt", "Sprintf").Call(jen.Lit("%s/spaces/%s/uploads"), jen.Id("s.client.uploadHost"), jen.Id("s.client.spaceID")),
+			jen.Id("r"),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("req").Dot("Header").Dot("Set").Call(
+			jen.Lit("Authorization"),
+			jen.Qual("fmt", "Sprintf").Call(jen.Lit("Bearer %s"), jen.Id("s.client.authToken")),
+		),
+		jen.Id("req").Dot("Header").Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit("application/octet-stream")),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("s.client.client.Do").Call(jen.Id("req")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp.StatusCode").Op("<").Lit(200).Op("||").Id("resp.StatusCode").Op(">=").Lit(300)).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("uploadItem"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Nil(), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Op("&").Id("Upload").Values(jen.Dict{
+			jen.Id("ID"):        jen.Id("data.Sys.ID"),
+			jen.Id("ExpiresAt"): jen.Id("data.Sys.ExpiresAt"),
+		}), jen.Nil()),
+	)
+
+	f.Comment("send executes an asset request with an optional payload and updates a from the response")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("send").Params(
+		jen.List(jen.Id("method"), jen.Id("path")).String(),
+		jen.Id("header").Qual("net/http", "Header"),
+		jen.Id("a").Op("*").Id("ManagedAsset"),
+		jen.Id("in").Interface(),
+	).Error().Block(
+		jen.Var().Id("out").Id("managedAssetItem"),
+		jen.If(
+			jen.Err().Op(":=").Id("s.client").Dot("do").Call(jen.Id("method"), jen.Id("path"), jen.Id("header"), jen.Id("in"), jen.Op("&").Id("out")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("a.ID").Op("=").Id("out.Sys.ID"),
+		jen.Id("a.Version").Op("=").Id("out.Sys.Version"),
+		jen.Id("a.Fields").Op("=").Id("out.Fields"),
+		jen.Return(jen.Nil()),
+	)
+
+	payload := func() jen.Code {
+		return jen.Id("managedAssetItem").Values(jen.Dict{
+			jen.Id("Fields"): jen.Id("a.Fields"),
+		})
+	}
+	path := func(suffix string) *jen.Statement {
+		p := jen.Lit("/assets/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("a.ID"))
+		if suffix != "" {
+			return p.Op("+").Lit(suffix)
+		}
+		return p
+	}
+
+	f.Comment("Get retrieves a single asset by its ID")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Get").Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id("ManagedAsset"), jen.Error(),
+	).Block(
+		jen.Id("a").Op(":=").Op("&").Id("ManagedAsset").Values(jen.Dict{
+			jen.Id("ID"): jen.Id("id"),
+		}),
+		jen.If(
+			jen.Err().Op(":=").Id("s").Dot("send").Call(jen.Lit("GET"), path(""), jen.Nil(), jen.Id("a"), jen.Nil()),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Id("a"), jen.Nil()),
+	)
+
+	f.Comment("Create adds a new asset. The asset is created with the given ID if set. Its files have to be processed before publishing")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Create").Params(
+		jen.Id("a").Op("*").Id("ManagedAsset"),
+	).Error().Block(
+		jen.If(jen.Id("a.ID").Op("==").Lit("")).Block(
+			jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("POST"), jen.Lit("/assets"), jen.Nil(), jen.Id("a"), payload())),
+		),
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Nil(), jen.Id("a"), payload())),
+	)
+
+	f.Comment("Update replaces the fields of an existing asset. It fails if the asset changed since a.Version")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Update").Params(
+		jen.Id("a").Op("*").Id("ManagedAsset"),
+	).Error().Block(
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Id("versionHeader").Call(jen.Id("a.Version")), jen.Id("a"), payload())),
+	)
+
+	f.Comment("UpdateWithRetry applies fn to the latest version of an asset and updates it, retrying with a fresh version on conflicts")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("UpdateWithRetry").Params(
+		jen.Id("id").String(),
+		jen.Id("fn").Func().Params(jen.Op("*").Id("ManagedAsset")).Error(),
+	).Params(
+		jen.Op("*").Id("ManagedAsset"), jen.Error(),
+	).Block(
+		jen.Return(jen.Id("updateWithRetry").Call(
+			jen.Func().Params().Params(jen.Op("*").Id("ManagedAsset"), jen.Error()).Block(
+				jen.Return(jen.Id("s").Dot("Get").Call(jen.Id("id"))),
+			),
+			jen.Id("s.Update"),
+			jen.Id("fn"),
+		)),
+	)
+
+	f.Comment("Delete removes an unpublished asset")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Delete").Params(
+		jen.Id("id").String(),
+	).Error().Block(
+		jen.Return(jen.Id("s.client").Dot("do").Call(jen.Lit("DELETE"), jen.Lit("/assets/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("id")), jen.Nil(), jen.Nil(), jen.Nil())),
+	)
+
+	for _, action := range []struct {
+		name, method, suffix, comment string
+	}{
+		{"Publish", "PUT", "/published", "makes the current version of an asset available in the delivery api"},
+		{"Unpublish", "DELETE", "/published", "removes an asset from the delivery api"},
+		{"Archive", "PUT", "/archived", "archives an unpublished asset"},
+		{"Unarchive", "DELETE", "/archived", "restores an archived asset"},
+	} {
+		f.Commentf("%s %s", action.name, action.comment)
+		f.Func().Params(
+			jen.Id("s").Op("*").Id("AssetService"),
+		).Id(action.name).Params(
+			jen.Id("a").Op("*").Id("ManagedAsset"),
+		).Error().Block(
+			jen.Return(jen.Id("s").Dot("send").Call(jen.Lit(action.method), path(action.suffix), jen.Id("versionHeader").Call(jen.Id("a.Version")), jen.Id("a"), jen.Nil())),
+		)
+	}
+
+	f.Comment("Process starts processing the files of the given locales, or of all locales if none is given. Processing finishes asynchronously, see WaitProcessed. Each request bumps the asset version, a is refetched after every locale to send the current one")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("Process").Params(
+		jen.Id("a").Op("*").Id("ManagedAsset"),
+		jen.Id("locales").Op("...").String(),
+	).Error().Block(
+		jen.If(jen.Len(jen.Id("locales")).Op("==").Lit(0)).Block(
+			jen.For(jen.Id("locale").Op(":=").Range().Id("a.Fields.File")).Block(
+				jen.Id("locales").Op("=").Append(jen.Id("locales"), jen.Id("locale")),
+			),
+			jen.Qual("sort", "Strings").Call(jen.Id("locales")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("locale")).Op(":=").Range().Id("locales")).Block(
+			jen.If(
+				jen.Err().Op(":=").Id("s.client").Dot("do").Call(
+					jen.Lit("PUT"),
+					path("/files/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("locale")).Op("+").Lit("/process"),
+					jen.Id("versionHeader").Call(jen.Id("a.Version")),
+					jen.Nil(),
+					jen.Nil(),
+				),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Err()),
+			),
+			jen.If(
+				jen.Err().Op(":=").Id("s").Dot("send").Call(jen.Lit("GET"), path(""), jen.Nil(), jen.Id("a"), jen.Nil()),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Err()),
+			),
+		),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("WaitProcessed polls an asset until the files of the given locales, or of all locales if none is given, are processed. ErrProcessingTimeout is returned once timeout is exceeded")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("WaitProcessed").Params(
+		jen.Id("id").String(),
+		jen.Id("timeout").Qual("time", "Duration"),
+		jen.Id("locales").Op("...").String(),
+	).Params(
+		jen.Op("*").Id("ManagedAsset"), jen.Error(),
+	).Block(
+		jen.Id("deadline").Op(":=").Qual("time", "Now").Call().Dot("Add").Call(jen.Id("timeout")),
+		jen.For().Block(
+			jen.List(jen.Id("a"), jen.Err()).Op(":=").Id("s").Dot("Get").Call(jen.Id("id")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.If(jen.Id("a").Dot("processed").Call(jen.Id("locales"))).Block(
+				jen.Return(jen.Id("a"), jen.Nil()),
+			),
+			jen.If(jen.Qual("time", "Now").Call().Dot("Add").Call(jen.Id("assetPollInterval")).Dot("After").Call(jen.Id("deadline"))).Block(
+				jen.Return(jen.Nil(), jen.Id("ErrProcessingTimeout")),
+			),
+			jen.Qual("time", "Sleep").Call(jen.Id("assetPollInterval")),
+		),
+	)
+
+	f.Comment("ManagedAssetIterator is used to paginate assets of the management api")
+	f.Type().Id("ManagedAssetIterator").Struct(
+		append(
+			iteratorFields(),
+			jen.Id("c").Op("*").Id("ManagementClient"),
+			jen.Id("items").Index().Op("*").Id("ManagedAsset"),
+		)...,
+	)
+
+	generateIteratorMethods(f, "ManagedAssetIterator", "ManagedAsset")
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id("ManagedAssetIterator"),
+	).Id("fetch").Params().Id("error").Block(
+		jen.Var().Id("data").Struct(
+			jen.Id("Total").Int().Tag(map[string]string{"json": "total"}),
+			jen.Id("Items").Index().Id("managedAssetItem").Tag(map[string]string{"json": "items"}),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("it.c").Dot("do").Call(
+				jen.Lit("GET"),
+				jen.Qual("fmt", "Sprintf").Call(
+					jen.Lit("/assets?limit=%d&skip=%d&order=%s"),
+					jen.Id("it.Limit"),
+					jen.Id("it.Offset"),
+					jen.Id("defaultOrder"),
+				),
+				jen.Nil(),
+				jen.Nil(),
+				jen.Op("&").Id("data"),
+			),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("items").Op(":=").Make(jen.Index().Op("*").Id("ManagedAsset"), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
+			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id("ManagedAsset").Values(jen.Dict{
+				jen.Id("ID"):      jen.Id("raw.Sys.ID"),
+				jen.Id("Version"): jen.Id("raw.Sys.Version"),
+				jen.Id("Fields"):  jen.Id("raw.Fields"),
+			}),
+		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("List retrieves paginated assets including drafts")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("AssetService"),
+	).Id("List").Params(
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id("ManagedAssetIterator").Block(
+		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
+			jen.Id("opts.Limit").Op("=").Lit(100),
+		),
+		jen.Return(jen.Op("&").Id("ManagedAssetIterator").Values(jen.Dict{
+			jen.Id("Limit"):  jen.Id("opts.Limit"),
+			jen.Id("Offset"): jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("c"):      jen.Id("s.client"),
+		})),
+	)
+}
@@ -0,0 +1,299 @@
+package main
+
+import "github.com/dave/jennifer/jen"
+
+// generateManagementContentTypes adds the public counterparts of contentfulModel, field and validation
+// and a service managing them through the management api
+func generateManagementContentTypes(f *jen.File) {
+	f.Comment("Validation restricts the values of a field. Validations without a dedicated field are kept in Other as raw json")
+	f.Type().Id("Validation").Struct(
+		jen.Id("LinkContentType").Index().String(),
+		jen.Id("Unique").Bool(),
+		jen.Id("Other").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+	)
+
+	f.Comment("UnmarshalJSON decodes a validation, keeping unknown validations in Other")
+	f.Func().Params(
+		jen.Id("v").Op("*").Id("Validation"),
+	).Id("UnmarshalJSON").Params(
+		jen.Id("b").Index().Byte(),
+	).Error().Block(
+		jen.Var().Id("raw").Map(jen.String()).Qual("encoding/json", "RawMessage"),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("raw")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Op("*").Id("v").Op("=").Id("Validation").Values(),
+		jen.For(jen.List(jen.Id("k"), jen.Id("value")).Op(":=").Range().Id("raw")).Block(
+			jen.Var().Err().Error(),
+			jen.Switch(jen.Id("k")).Block(
+				jen.Case(jen.Lit("linkContentType")).Block(
+					jen.Err().Op("=").Qual("encoding/json", "Unmarshal").Call(jen.Id("value"), jen.Op("&").Id("v.LinkContentType")),
+				),
+				jen.Case(jen.Lit("unique")).Block(
+					jen.Err().Op("=").Qual("encoding/json", "Unmarshal").Call(jen.Id("value"), jen.Op("&").Id("v.Unique")),
+				),
+				jen.Default().Block(
+					jen.If(jen.Id("v.Other").Op("==").Nil()).Block(
+						jen.Id("v.Other").Op("=").Map(jen.String()).Qual("encoding/json", "RawMessage").Values(),
+					),
+					jen.Id("v.Other").Index(jen.Id("k")).Op("=").Id("value"),
+				),
+			),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Err()),
+			),
+		),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("MarshalJSON encodes a validation including the validations in Other")
+	f.Func().Params(
+		jen.Id("v").Id("Validation"),
+	).Id("MarshalJSON").Params().Params(
+		jen.Index().Byte(), jen.Error(),
+	).Block(
+		jen.Id("out").Op(":=").Make(jen.Map(jen.String()).Interface(), jen.Len(jen.Id("v.Other")).Op("+").Lit(2)),
+		jen.For(jen.List(jen.Id("k"), jen.Id("value")).Op(":=").Range().Id("v.Other")).Block(
+			jen.Id("out").Index(jen.Id("k")).Op("=").Id("value"),
+		),
+		jen.If(jen.Len(jen.Id("v.LinkContentType")).Op(">").Lit(0)).Block(
+			jen.Id("out").Index(jen.Lit("linkContentType")).Op("=").Id("v.LinkContentType"),
+		),
+		jen.If(jen.Id("v.Unique")).Block(
+			jen.Id("out").Index(jen.Lit("unique")).Op("=").True(),
+		),
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Id("out"))),
+	)
+
+	f.Comment("FieldItems describes the values of an Array field")
+	f.Type().Id("FieldItems").Struct(
+		jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+		jen.Id("LinkType").String().Tag(map[string]string{"json": "linkType,omitempty"}),
+		jen.Id("Validations").Index().Id("Validation").Tag(map[string]string{"json": "validations,omitempty"}),
+	)
+
+	f.Comment("Field describes a field of a content type")
+	f.Type().Id("Field").Struct(
+		jen.Id("ID").String().Tag(map[string]string{"json": "id"}),
+		jen.Id("Name").String().Tag(map[string]string{"json": "name"}),
+		jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+		jen.Id("LinkType").String().Tag(map[string]string{"json": "linkType,omitempty"}),
+		jen.Id("Localized").Bool().Tag(map[string]string{"json": "localized"}),
+		jen.Id("Required").Bool().Tag(map[string]string{"json": "required"}),
+		jen.Id("Disabled").Bool().Tag(map[string]string{"json": "disabled"}),
+		jen.Id("Omitted").Bool().Tag(map[string]string{"json": "omitted"}),
+		jen.Id("Items").Op("*").Id("FieldItems").Tag(map[string]string{"json": "items,omitempty"}),
+		jen.Id("Validations").Index().Id("Validation").Tag(map[string]string{"json": "validations,omitempty"}),
+		jen.Id("DefaultValue").Map(jen.String()).Interface().Tag(map[string]string{"json": "defaultValue,omitempty"}),
+	)
+
+	f.Comment("ContentType describes a content model of the management api. Changes only affect the delivery api once published")
+	f.Type().Id("ContentType").Struct(
+		jen.Id("ID").String().Tag(map[string]string{"json": "-"}),
+		jen.Id("Version").Int().Tag(map[string]string{"json": "-"}),
+		jen.Id("Name").String().Tag(map[string]string{"json": "name"}),
+		jen.Id("Description").String().Tag(map[string]string{"json": "description,omitempty"}),
+		jen.Id("DisplayField").String().Tag(map[string]string{"json": "displayField,omitempty"}),
+		jen.Id("Fields").Index().Id("Field").Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Type().Id("contentTypeItem").Struct(
+		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
+		jen.Id("ContentType"),
+	)
+
+	f.Comment("ContentTypeService includes content type management functions")
+	f.Type().Id("ContentTypeService").Struct(
+		jen.Id("client").Op("*").Id("ManagementClient"),
+	)
+
+	f.Comment("ContentTypes returns a content type management service")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ManagementClient"),
+	).Id("ContentTypes").Params().Op("*").Id("ContentTypeService").Block(
+		jen.Return(jen.Op("&").Id("ContentTypeService").Values(jen.Dict{
+			jen.Id("client"): jen.Id("c"),
+		})),
+	)
+
+	f.Comment("send executes a content type request with an optional payload and updates ct from the response")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("send").Params(
+		jen.List(jen.Id("method"), jen.Id("path")).String(),
+		jen.Id("header").Qual("net/http", "Header"),
+		jen.Id("ct").Op("*").Id("ContentType"),
+		jen.Id("in").Interface(),
+	).Error().Block(
+		jen.Var().Id("out").Id("contentTypeItem"),
+		jen.If(
+			jen.Err().Op(":=").Id("s.client").Dot("do").Call(jen.Id("method"), jen.Id("path"), jen.Id("header"), jen.Id("in"), jen.Op("&").Id("out")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Op("*").Id("ct").Op("=").Id("out.ContentType"),
+		jen.Id("ct.ID").Op("=").Id("out.Sys.ID"),
+		jen.Id("ct.Version").Op("=").Id("out.Sys.Version"),
+		jen.Return(jen.Nil()),
+	)
+
+	path := func(suffix string) *jen.Statement {
+		p := jen.Lit("/content_types/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("ct.ID"))
+		if suffix != "" {
+			return p.Op("+").Lit(suffix)
+		}
+		return p
+	}
+
+	f.Comment("Get retrieves the current draft of a content type by its ID")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Get").Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id("ContentType"), jen.Error(),
+	).Block(
+		jen.Id("ct").Op(":=").Op("&").Id("ContentType").Values(jen.Dict{
+			jen.Id("ID"): jen.Id("id"),
+		}),
+		jen.If(
+			jen.Err().Op(":=").Id("s").Dot("send").Call(jen.Lit("GET"), path(""), jen.Nil(), jen.Id("ct"), jen.Nil()),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Id("ct"), jen.Nil()),
+	)
+
+	f.Comment("Create adds a new content type. The content type is created with the given ID if set")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Create").Params(
+		jen.Id("ct").Op("*").Id("ContentType"),
+	).Error().Block(
+		jen.If(jen.Id("ct.ID").Op("==").Lit("")).Block(
+			jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("POST"), jen.Lit("/content_types"), jen.Nil(), jen.Id("ct"), jen.Id("ct"))),
+		),
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Nil(), jen.Id("ct"), jen.Id("ct"))),
+	)
+
+	f.Comment("Update replaces an existing content type. It fails if the content type changed since ct.Version")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Update").Params(
+		jen.Id("ct").Op("*").Id("ContentType"),
+	).Error().Block(
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Id("versionHeader").Call(jen.Id("ct.Version")), jen.Id("ct"), jen.Id("ct"))),
+	)
+
+	f.Comment("UpdateWithRetry applies fn to the latest version of a content type and updates it, retrying with a fresh version on conflicts")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("UpdateWithRetry").Params(
+		jen.Id("id").String(),
+		jen.Id("fn").Func().Params(jen.Op("*").Id("ContentType")).Error(),
+	).Params(
+		jen.Op("*").Id("ContentType"), jen.Error(),
+	).Block(
+		jen.Return(jen.Id("updateWithRetry").Call(
+			jen.Func().Params().Params(jen.Op("*").Id("ContentType"), jen.Error()).Block(
+				jen.Return(jen.Id("s").Dot("Get").Call(jen.Id("id"))),
+			),
+			jen.Id("s.Update"),
+			jen.Id("fn"),
+		)),
+	)
+
+	f.Comment("Delete removes an unpublished content type without entries")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Delete").Params(
+		jen.Id("id").String(),
+	).Error().Block(
+		jen.Return(jen.Id("s.client").Dot("do").Call(jen.Lit("DELETE"), jen.Lit("/content_types/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("id")), jen.Nil(), jen.Nil(), jen.Nil())),
+	)
+
+	f.Comment("Publish activates the current version of a content type")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Publish").Params(
+		jen.Id("ct").Op("*").Id("ContentType"),
+	).Error().Block(
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path("/published"), jen.Id("versionHeader").Call(jen.Id("ct.Version")), jen.Id("ct"), jen.Nil())),
+	)
+
+	f.Comment("Unpublish deactivates a content type. Content types with entries cannot be deactivated")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("Unpublish").Params(
+		jen.Id("ct").Op("*").Id("ContentType"),
+	).Error().Block(
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("DELETE"), path("/published"), jen.Id("versionHeader").Call(jen.Id("ct.Version")), jen.Id("ct"), jen.Nil())),
+	)
+
+	f.Comment("ContentTypeIterator is used to paginate content types of the management api")
+	f.Type().Id("ContentTypeIterator").Struct(
+		append(
+			iteratorFields(),
+			jen.Id("c").Op("*").Id("ManagementClient"),
+			jen.Id("items").Index().Op("*").Id("ContentType"),
+		)...,
+	)
+
+	generateIteratorMethods(f, "ContentTypeIterator", "ContentType")
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id("ContentTypeIterator"),
+	).Id("fetch").Params().Id("error").Block(
+		jen.Var().Id("data").Struct(
+			jen.Id("Total").Int().Tag(map[string]string{"json": "total"}),
+			jen.Id("Items").Index().Id("contentTypeItem").Tag(map[string]string{"json": "items"}),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("it.c").Dot("do").Call(
+				jen.Lit("GET"),
+				jen.Qual("fmt", "Sprintf").Call(
+					jen.Lit("/content_types?limit=%d&skip=%d&order=%s"),
+					jen.Id("it.Limit"),
+					jen.Id("it.Offset"),
+					jen.Id("defaultOrder"),
+				),
+				jen.Nil(),
+				jen.Nil(),
+				jen.Op("&").Id("data"),
+			),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("items").Op(":=").Make(jen.Index().Op("*").Id("ContentType"), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
+			jen.Id("ct").Op(":=").Id("raw.ContentType"),
+			jen.Id("ct.ID").Op("=").Id("raw.Sys.ID"),
+			jen.Id("ct.Version").Op("=").Id("raw.Sys.Version"),
+			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id("ct"),
+		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("List retrieves paginated content types including unpublished drafts")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("ContentTypeService"),
+	).Id("List").Params(
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id("ContentTypeIterator").Block(
+		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
+			jen.Id("opts.Limit").Op("=").Lit(100),
+		),
+		jen.Return(jen.Op("&").Id("ContentTypeIterator").Values(jen.Dict{
+			jen.Id("Limit"):  jen.Id("opts.Limit"),
+			jen.Id("Offset"): jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("c"):      jen.Id("s.client"),
+		})),
+	)
+}
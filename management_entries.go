@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+// managementFieldType returns the go type of a single locale value of a field in management api payloads
+func managementFieldType(f field) (jen.Code, bool) {
+	switch f.Type {
+	case "Symbol", "Text":
+		return jen.String(), true
+	case "Integer":
+		return jen.Int64(), true
+	case "Number":
+		return jen.Float64(), true
+	case "Boolean":
+		return jen.Bool(), true
+	case "Date":
+		return jen.Id("Date"), true
+	case "Link":
+		return jen.Id("Link"), true
+	case "Array":
+		switch f.Items.Type {
+		case "Symbol", "Text":
+			return jen.Index().String(), true
+		case "Link":
+			return jen.Index().Id("Link"), true
+		}
+	}
+	return nil, false
+}
+
+func generateManagementEntries(f *jen.File) {
+	for _, m := range models {
+		generateManagementEntry(f, m)
+	}
+}
+
+func generateManagementEntry(f *jen.File, m contentfulModel) {
+	entry := fmt.Sprintf("%sEntry", m.Name)
+	fields := fmt.Sprintf("%sEntryFields", m.Name)
+	item := fmt.Sprintf("%sEntryItem", m.DowncasedName())
+	iterator := fmt.Sprintf("%sEntryIterator", m.Name)
+	service := fmt.Sprintf("%sEntryService", m.Name)
+
+	f.Commentf("%s holds the %s fields in the management api format, mapping locale codes to values", fields, m.Name)
+	f.Type().Id(fields).StructFunc(func(g *jen.Group) {
+		for _, field := range m.Fields {
+			typ, ok := managementFieldType(field)
+			if !ok {
+				continue
+			}
+			g.Id(fieldName(field)).Map(jen.String()).Add(typ).Tag(map[string]string{"json": field.Name + ",omitempty"})
+		}
+	})
+
+	f.Commentf("%s is a %s entry of the management api", entry, m.Name)
+	f.Type().Id(entry).Struct(
+		jen.Id("ID").String().Tag(map[string]string{"json": "-"}),
+		jen.Id("Version").Int().Tag(map[string]string{"json": "-"}),
+		jen.Id("Fields").Id(fields).Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Type().Id(item).Struct(
+		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
+		jen.Id("Fields").Id(fields).Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Commentf("%s includes management functions for %s entries", service, m.Name)
+	f.Type().Id(service).Struct(
+		jen.Id("client").Op("*").Id("ManagementClient"),
+	)
+
+	f.Commentf("%s returns a %s entry management service", inflector.Pluralize(m.Name), m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ManagementClient"),
+	).Id(inflector.Pluralize(m.Name)).Params().Op("*").Id(service).Block(
+		jen.Return(jen.Op("&").Id(service).Values(jen.Dict{
+			jen.Id("client"): jen.Id("c"),
+		})),
+	)
+
+	f.Comment("send executes an entry request with an optional payload and updates e from the response")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("send").Params(
+		jen.List(jen.Id("method"), jen.Id("path")).String(),
+		jen.Id("header").Qual("net/http", "Header"),
+		jen.Id("e").Op("*").Id(entry),
+		jen.Id("in").Interface(),
+	).Error().Block(
+		jen.Var().Id("out").Id(item),
+		jen.If(
+			jen.Err().Op(":=").Id("s.client").Dot("do").Call(jen.Id("method"), jen.Id("path"), jen.Id("header"), jen.Id("in"), jen.Op("&").Id("out")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(jen.Id("out.Sys.ContentType.Sys.ID").Op("!=").Lit(m.Sys.ID)).Block(
+			jen.Return(jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"):          jen.Id("out.Sys.ID"),
+				jen.Id("ContentType"): jen.Lit(m.Sys.ID),
+			})),
+		),
+		jen.Id("e.ID").Op("=").Id("out.Sys.ID"),
+		jen.Id("e.Version").Op("=").Id("out.Sys.Version"),
+		jen.Id("e.Fields").Op("=").Id("out.Fields"),
+		jen.Return(jen.Nil()),
+	)
+
+	payload := func() jen.Code {
+		return jen.Id(item).Values(jen.Dict{
+			jen.Id("Fields"): jen.Id("e.Fields"),
+		})
+	}
+	path := func(suffix string) jen.Code {
+		return jen.Lit("/entries/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("e.ID")).Op("+").Lit(suffix)
+	}
+
+	f.Commentf("Get retrieves a single %s entry by its ID", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("Get").Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id(entry), jen.Error(),
+	).Block(
+		jen.Id("e").Op(":=").Op("&").Id(entry).Values(jen.Dict{
+			jen.Id("ID"): jen.Id("id"),
+		}),
+		jen.If(
+			jen.Err().Op(":=").Id("s").Dot("send").Call(jen.Lit("GET"), path(""), jen.Nil(), jen.Id("e"), jen.Nil()),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Id("e"), jen.Nil()),
+	)
+
+	f.Commentf("Create adds a new %s entry. The entry is created with the given ID if set", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("Create").Params(
+		jen.Id("e").Op("*").Id(entry),
+	).Error().Block(
+		jen.Id("header").Op(":=").Qual("net/http", "Header").Values(jen.Dict{
+			jen.Lit("X-Contentful-Content-Type"): jen.Index().String().Values(jen.Lit(m.Sys.ID)),
+		}),
+		jen.If(jen.Id("e.ID").Op("==").Lit("")).Block(
+			jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("POST"), jen.Lit("/entries"), jen.Id("header"), jen.Id("e"), payload())),
+		),
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Id("header"), jen.Id("e"), payload())),
+	)
+
+	f.Commentf("Update replaces the fields of an existing %s entry. It fails if the entry changed since e.Version", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("Update").Params(
+		jen.Id("e").Op("*").Id(entry),
+	).Error().Block(
+		jen.Return(jen.Id("s").Dot("send").Call(jen.Lit("PUT"), path(""), jen.Id("versionHeader").Call(jen.Id("e.Version")), jen.Id("e"), payload())),
+	)
+
+	f.Commentf("UpdateWithRetry applies fn to the latest version of a %s entry and updates it, retrying with a fresh version on conflicts", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("UpdateWithRetry").Params(
+		jen.Id("id").String(),
+		jen.Id("fn").Func().Params(jen.Op("*").Id(entry)).Error(),
+	).Params(
+		jen.Op("*").Id(entry), jen.Error(),
+	).Block(
+		jen.Return(jen.Id("updateWithRetry").Call(
+			jen.Func().Params().Params(jen.Op("*").Id(entry), jen.Error()).Block(
+				jen.Return(jen.Id("s").Dot("Get").Call(jen.Id("id"))),
+			),
+			jen.Id("s.Update"),
+			jen.Id("fn"),
+		)),
+	)
+
+	f.Commentf("Delete removes an unpublished %s entry", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("Delete").Params(
+		jen.Id("id").String(),
+	).Error().Block(
+		jen.Return(jen.Id("s.client").Dot("do").Call(jen.Lit("DELETE"), jen.Lit("/entries/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("id")), jen.Nil(), jen.Nil(), jen.Nil())),
+	)
+
+	for _, action := range []struct {
+		name, method, suffix, comment string
+	}{
+		{"Publish", "PUT", "/published", "makes the current version of a %s entry available in the delivery api"},
+		{"Unpublish", "DELETE", "/published", "removes a %s entry from the delivery api"},
+		{"Archive", "PUT", "/archived", "archives an unpublished %s entry"},
+		{"Unarchive", "DELETE", "/archived", "restores an archived %s entry"},
+	} {
+		f.Commentf("%s %s", action.name, fmt.Sprintf(action.comment, m.Name))
+		f.Func().Params(
+			jen.Id("s").Op("*").Id(service),
+		).Id(action.name).Params(
+			jen.Id("e").Op("*").Id(entry),
+		).Error().Block(
+			jen.Return(jen.Id("s").Dot("send").Call(jen.Lit(action.method), path(action.suffix), jen.Id("versionHeader").Call(jen.Id("e.Version")), jen.Id("e"), jen.Nil())),
+		)
+	}
+
+	f.Commentf("%s is used to paginate %s entries of the management api", iterator, m.Name)
+	f.Type().Id(iterator).Struct(
+		append(
+			iteratorFields(),
+			jen.Id("c").Op("*").Id("ManagementClient"),
+			jen.Id("items").Index().Op("*").Id(entry),
+		)...,
+	)
+
+	generateIteratorMethods(f, iterator, entry)
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(iterator),
+	).Id("fetch").Params().Id("error").Block(
+		jen.Var().Id("data").Struct(
+			jen.Id("Total").Int().Tag(map[string]string{"json": "total"}),
+			jen.Id("Items").Index().Id(item).Tag(map[string]string{"json": "items"}),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("it.c").Dot("do").Call(
+				jen.Lit("GET"),
+				jen.Qual("fmt", "Sprintf").Call(
+					jen.Lit(fmt.Sprintf("/entries?content_type=%s&limit=%%d&skip=%%d&order=%%s", m.Sys.ID)),
+					jen.Id("it.Limit"),
+					jen.Id("it.Offset"),
+					jen.Id("defaultOrder"),
+				),
+				jen.Nil(),
+				jen.Nil(),
+				jen.Op("&").Id("data"),
+			),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("items").Op(":=").Make(jen.Index().Op("*").Id(entry), jen.Len(jen.Id("data.Items"))),
+		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
+			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id(entry).Values(jen.Dict{
+				jen.Id("ID"):      jen.Id("raw.Sys.ID"),
+				jen.Id("Version"): jen.Id("raw.Sys.Version"),
+				jen.Id("Fields"):  jen.Id("raw.Fields"),
+			}),
+		),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Commentf("List retrieves paginated %s entries including drafts", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("List").Params(
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id(iterator).Block(
+		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
+			jen.Id("opts.Limit").Op("=").Lit(100),
+		),
+		jen.Return(jen.Op("&").Id(iterator).Values(jen.Dict{
+			jen.Id("Limit"):  jen.Id("opts.Limit"),
+			jen.Id("Offset"): jen.Id("opts.Page").Op("*").Id("opts.Limit"),
+			jen.Id("c"):      jen.Id("s.client"),
+		})),
+	)
+}
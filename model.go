@@ -22,7 +22,7 @@ func linkedContentTypes(vs []validation) []string {
 	return linkedTypes
 }
 
-func generateModelLinkResolver(model contentfulModel, items, includes, cache string) func(jen.Dict) {
+func generateModelLinkResolver(model contentfulModel, index, cache string) func(jen.Dict) {
 	return func(d jen.Dict) {
 		for _, field := range model.Fields {
 			fieldName := fieldName(field)
@@ -32,7 +32,8 @@ func generateModelLinkResolver(model contentfulModel, items, includes, cache str
 				case "Asset":
 					d[jen.Id(fieldName)] = jen.Id("resolveAsset").Call(
 						jen.Id("item").Dot("Fields").Dot(fieldName).Dot("Sys").Dot("ID"),
-						jen.Id(includes),
+						jen.Id(index),
+						jen.Id(cache),
 					)
 				case "Entry":
 					var linkedTypes = linkedContentTypes(field.Validations)
@@ -42,27 +43,33 @@ func generateModelLinkResolver(model contentfulModel, items, includes, cache str
 							// 1:1 recursive type relationship
 							d[jen.Id(fieldName)] = jen.Id(fmt.Sprintf("resolve%sPtr", linkedTypes[0])).Call(
 								jen.Id("item").Dot("Fields").Dot(fieldName).Dot("Sys").Dot("ID"),
-								jen.Id(items),
-								jen.Id(includes),
+								jen.Id(index),
 								jen.Id(cache),
 							)
 						} else {
 							// 1:1 type relationship
-							d[jen.Id(fieldName)] = jen.Id(fmt.Sprintf("resolve%s", linkedTypes[0])).Call(
+							d[jen.Id(fieldName)] = jen.Id(fmt.Sprintf("resolve%sRef", linkedTypes[0])).Call(
 								jen.Id("item").Dot("Fields").Dot(fieldName).Dot("Sys").Dot("ID"),
-								jen.Id(items),
-								jen.Id(includes),
+								jen.Id(index),
 								jen.Id(cache),
 							)
 						}
-					} else {
-						// 1:1 multi-type relationship
+					} else if len(linkedTypes) == 0 {
+						// 1:1 relationship to any content type
 						d[jen.Id(fieldName)] = jen.Id("resolveEntry").Call(
 							jen.Id("item").Dot("Fields").Dot(fieldName),
-							jen.Id(items),
-							jen.Id(includes),
+							jen.Id(index),
 							jen.Id(cache),
 						)
+					} else {
+						// 1:1 multi-type relationship
+						d[jen.Id(fieldName)] = jen.Id("refAs").Types(jen.Id(linkInterfaceName(model, field))).Call(
+							jen.Id("resolveEntry").Call(
+								jen.Id("item").Dot("Fields").Dot(fieldName),
+								jen.Id(index),
+								jen.Id(cache),
+							),
+						)
 					}
 				}
 			case "Array":
@@ -76,27 +83,33 @@ func generateModelLinkResolver(model contentfulModel, items, includes, cache str
 							// 1:N recursive type relationship
 							d[jen.Id(fieldName)] = jen.Id(fmt.Sprintf("resolve%ssPtr", linkedTypes[0])).Call(
 								jen.Id("item").Dot("Fields").Dot(fieldName),
-								jen.Id(items),
-								jen.Id(includes),
+								jen.Id(index),
 								jen.Id(cache),
 							)
 						} else {
 							// 1:N type relationship
 							d[jen.Id(fieldName)] = jen.Id(fmt.Sprintf("resolve%ss", linkedTypes[0])).Call(
 								jen.Id("item").Dot("Fields").Dot(fieldName),
-								jen.Id(items),
-								jen.Id(includes),
+								jen.Id(index),
 								jen.Id(cache),
 							)
 						}
-					} else {
-						// 1:N multi-type relationship
+					} else if len(linkedTypes) == 0 {
+						// 1:N relationship to any content type
 						d[jen.Id(fieldName)] = jen.Id("resolveEntries").Call(
 							jen.Id("item").Dot("Fields").Dot(fieldName),
-							jen.Id(items),
-							jen.Id(includes),
+							jen.Id(index),
 							jen.Id(cache),
 						)
+					} else {
+						// 1:N multi-type relationship
+						d[jen.Id(fieldName)] = jen.Id("refsAs").Types(jen.Id(linkInterfaceName(model, field))).Call(
+							jen.Id("resolveEntries").Call(
+								jen.Id("item").Dot("Fields").Dot(fieldName),
+								jen.Id(index),
+								jen.Id(cache),
+							),
+						)
 					}
 				case "Symbol":
 					d[jen.Id(fieldName)] = jen.Id("item").Dot("Fields").Dot(fieldName)
@@ -106,11 +119,11 @@ func generateModelLinkResolver(model contentfulModel, items, includes, cache str
 	}
 }
 
-func generateModelResolvers(model contentfulModel, items, includes, cache string, includeResolvers bool) jen.Dict {
+func generateModelResolvers(model contentfulModel, index, cache string, includeResolvers bool) jen.Dict {
 	d := jen.Dict{}
 
 	if includeResolvers {
-		generateModelLinkResolver(model, items, includes, cache)(d)
+		generateModelLinkResolver(model, index, cache)(d)
 	}
 
 	for _, field := range model.Fields {
@@ -182,7 +195,7 @@ func generateModelAttributes(m contentfulModel) func(*jen.Group) {
 			case "Link":
 				switch field.LinkType {
 				case "Asset":
-					g.Id(fieldName).Id("Asset")
+					g.Id(fieldName).Id("Ref").Types(jen.Id("Asset"))
 				case "Entry":
 					var linkedTypes = linkedContentTypes(field.Validations)
 
@@ -190,14 +203,17 @@ func generateModelAttributes(m contentfulModel) func(*jen.Group) {
 					if len(linkedTypes) == 1 {
 						if m.Name == linkedTypes[0] {
 							// 1:1 recursive type relationship
-							g.Id(fieldName).Op("*").Id(linkedTypes[0])
+							g.Id(fieldName).Id("Ref").Types(jen.Op("*").Id(linkedTypes[0]))
 						} else {
 							// 1:1 type relationship
-							g.Id(fieldName).Id(linkedTypes[0])
+							g.Id(fieldName).Id("Ref").Types(jen.Id(linkedTypes[0]))
 						}
+					} else if len(linkedTypes) == 0 {
+						// 1:1 relationship to any content type
+						g.Id(fieldName).Id("Ref").Types(jen.Id("Entry"))
 					} else {
 						// 1:1 multi-type relationship
-						g.Id(fieldName).Interface()
+						g.Id(fieldName).Id("Ref").Types(jen.Id(linkInterfaceName(m, field)))
 					}
 				}
 			case "Array":
@@ -211,14 +227,17 @@ func generateModelAttributes(m contentfulModel) func(*jen.Group) {
 					if len(linkedTypes) == 1 {
 						if m.Name == linkedTypes[0] {
 							// 1:N recursive type relationship
-							g.Id(fieldName).Index().Op("*").Id(linkedTypes[0])
+							g.Id(fieldName).Index().Id("Ref").Types(jen.Op("*").Id(linkedTypes[0]))
 						} else {
 							// 1:N type relationship
-							g.Id(fieldName).Index().Id(linkedTypes[0])
+							g.Id(fieldName).Index().Id("Ref").Types(jen.Id(linkedTypes[0]))
 						}
+					} else if len(linkedTypes) == 0 {
+						// 1:N relationship to any content type
+						g.Id(fieldName).Index().Id("Ref").Types(jen.Id("Entry"))
 					} else {
 						// 1:N multi-type relationship
-						g.Id(fieldName).Index().Interface()
+						g.Id(fieldName).Index().Id("Ref").Types(jen.Id(linkInterfaceName(m, field)))
 					}
 				}
 			}
@@ -231,93 +250,206 @@ func generateIteratorCacheType(f *jen.File) {
 		for _, m := range models {
 			g.Id(fmt.Sprintf("%ss", m.DowncasedName())).Map(jen.String()).Op("*").Id(m.Name)
 		}
+		g.Id("fetched").Op("*").Id("linkIndex")
+		g.Id("locale").String()
+		g.Id("shared").Op("*").Id("sharedCache")
+	})
+
+	f.Func().Id("newIteratorCache").Params().Op("*").Id("iteratorCache").Block(
+		jen.Return(jen.Op("&").Id("iteratorCache").Values(jen.DictFunc(func(d jen.Dict) {
+			for _, m := range models {
+				d[jen.Id(fmt.Sprintf("%ss", m.DowncasedName()))] = jen.Make(jen.Map(jen.String()).Op("*").Id(m.Name))
+			}
+			d[jen.Id("fetched")] = jen.Id("newLinkIndex").Call(jen.Nil(), jen.Id("includes").Values())
+		}))),
+	)
+
+	f.Comment("has reports whether an entry of any content type has already been resolved")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("iteratorCache"),
+	).Id("has").Params(
+		jen.Id("id").String(),
+	).Bool().BlockFunc(func(g *jen.Group) {
+		for _, m := range models {
+			g.If(
+				jen.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("c.%ss", m.DowncasedName())).Index(jen.Id("id")),
+				jen.Id("ok"),
+			).Block(
+				jen.Return(jen.True()),
+			)
+		}
+		g.List(jen.Id("_"), jen.Id("ok")).Op(":=").Id("c.shared").Dot("get").Call(jen.Lit("Entry:").Op("+").Id("id"))
+		g.Return(jen.Id("ok"))
 	})
 }
 
 func generateModelType(f *jen.File, m contentfulModel) {
 	f.Commentf("%sIterator is used to paginate result sets of %s", m.Name, m.Name)
 	f.Type().Id(fmt.Sprintf("%sIterator", m.Name)).Struct(
-		jen.Id("Page").Int(),
-		jen.Id("Limit").Int(),
-		jen.Id("Offset").Int(),
-		jen.Id("IncludeCount").Int(),
-		jen.Id("c").Op("*").Id("ContentClient"),
-		jen.Id("items").Index().Op("*").Id(m.Name),
-		jen.Id("lookupCache").Op("*").Id("iteratorCache"),
+		append(
+			iteratorFields(),
+			jen.Id("IncludeCount").Int(),
+			jen.Id("c").Op("*").Id("ContentClient"),
+			jen.Id("items").Index().Op("*").Id(m.Name),
+			jen.Id("lookupCache").Op("*").Id("iteratorCache"),
+			jen.Id("locale").String(),
+			jen.Id("query").String(),
+			jen.Id("pageLimit").Int(),
+			jen.Id("prefetch").Op("*").Id("prefetcher").Types(jen.Op("*").Id(fmt.Sprintf("%sResponse", m.DowncasedName()))),
+			jen.Id("errors").Index().Id("IncludeError"),
+		)...,
 	)
 
-	f.Commentf("Next returns the following item of type %s. If none exists a network request will be executed", m.Name)
+	generateIteratorMethods(f, fmt.Sprintf("%sIterator", m.Name), m.Name)
+
+	f.Comment("Errors returns the links contentful reported as unresolvable in the pages fetched so far")
 	f.Func().Params(
 		jen.Id("it").Op("*").Id(fmt.Sprintf("%sIterator", m.Name)),
-	).Id("Next").Params().Params(
-		jen.Op("*").Id(m.Name), jen.Id("error"),
-	).Block(
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.If(
-				jen.Err().Op(":=").Id("it.fetch").Call(),
-				jen.Err().Op("!=").Nil(),
-			).Block(
-				jen.Return(jen.Nil(), jen.Err()),
+	).Id("Errors").Params().Index().Id("IncludeError").Block(
+		jen.Return(jen.Id("it.errors")),
+	)
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(fmt.Sprintf("%sIterator", m.Name)),
+	).Id("params").Params(
+		jen.List(jen.Id("offset"), jen.Id("limit")).Int(),
+	).String().Block(
+		jen.Id("params").Op(":=").Qual("fmt", "Sprintf").Call(
+			jen.Lit("include=%d&limit=%d&skip=%d"),
+			jen.Id("it.IncludeCount"),
+			jen.Id("limit"),
+			jen.Id("offset"),
+		),
+		jen.If(jen.Id("it.query").Op("!=").Lit("")).Block(
+			jen.Id("params").Op("+=").Lit("&").Op("+").Id("it.query"),
+		),
+		jen.Return(jen.Id("params")),
+	)
+
+	f.Func().Params(
+		jen.Id("it").Op("*").Id(fmt.Sprintf("%sIterator", m.Name)),
+	).Id("fetch").Params().Id("error").Block(
+		jen.If(jen.Id("it.pageLimit").Op("<=").Lit(0).Op("||").Id("it.pageLimit").Op(">").Id("it.Limit")).Block(
+			jen.Id("it.pageLimit").Op("=").Id("it.Limit"),
+		),
+		jen.List(jen.Id("data"), jen.Id("ok"), jen.Err()).Op(":=").Id("it.prefetch").Dot("take").Call(),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.List(jen.Id("data"), jen.Err()).Op("=").Id("it.c").Dot(fmt.Sprintf("request%s", inflector.Pluralize(m.Name))).Call(
+				jen.Id("it.locale"),
+				jen.Id("it.params").Call(jen.Id("it.Offset"), jen.Id("it.pageLimit")),
 			),
 		),
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.Return(jen.Nil(), jen.Id("ErrIteratorDone")),
+		jen.Comment("contentful rejects large responses, retry with smaller pages"),
+		jen.For(jen.Qual("errors", "Is").Call(jen.Err(), jen.Id("ErrResponseTooBig")).Op("&&").Id("it.pageLimit").Op(">").Lit(1)).Block(
+			jen.Id("it.pageLimit").Op("/=").Lit(2),
+			jen.List(jen.Id("data"), jen.Err()).Op("=").Id("it.c").Dot(fmt.Sprintf("request%s", inflector.Pluralize(m.Name))).Call(
+				jen.Id("it.locale"),
+				jen.Id("it.params").Call(jen.Id("it.Offset"), jen.Id("it.pageLimit")),
+			),
 		),
-		jen.Var().Id("item").Op("*").Id(m.Name),
-		jen.List(
-			jen.Id("item"),
-			jen.Id("it.items"),
-		).Op("=").List(
-			jen.Id("it.items").Index(jen.Len(jen.Id("it.items")).Op("-").Lit(1)),
-			jen.Id("it.items").Index(jen.Empty(), jen.Len(jen.Id("it.items")).Op("-").Lit(1)),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
 		),
-		jen.If(jen.Len(jen.Id("it.items")).Op("==").Lit(0)).Block(
-			jen.Id("it.Page").Op("++"),
-			jen.Id("it.Offset").Op("=").Id("it.Page").Op("*").Id("it.Limit"),
+		jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("it.c").Dot(fmt.Sprintf("decode%s", inflector.Pluralize(m.Name))).Call(
+			jen.Id("data"),
+			jen.Id("it.lookupCache"),
 		),
-		jen.Return(jen.Id("item, nil")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("it.errors").Op("=").Append(jen.Id("it.errors"), jen.Id("data.Errors...")),
+		jen.Id("it.setPage").Call(jen.Id("items"), jen.Id("data.Total")),
+		jen.If(jen.Id("it.pageLimit").Op("<").Id("it.Limit")).Block(
+			jen.Id("it.pageLimit").Op("=").Min(jen.Id("it.pageLimit").Op("*").Lit(2), jen.Id("it.Limit")),
+		),
+		jen.Id("limit").Op(":=").Id("it.pageLimit"),
+		jen.Id("it.prefetch").Dot("fill").Call(
+			jen.Id("it.Offset"),
+			jen.Id("limit"),
+			jen.Id("it.total"),
+			jen.Func().Params(jen.Id("offset").Int()).Params(jen.Op("*").Id(fmt.Sprintf("%sResponse", m.DowncasedName())), jen.Error()).Block(
+				jen.Return(jen.Id("it.c").Dot(fmt.Sprintf("request%s", inflector.Pluralize(m.Name))).Call(
+					jen.Id("it.locale"),
+					jen.Id("it.params").Call(jen.Id("offset"), jen.Id("limit")),
+				)),
+			),
+		),
+		jen.Return(jen.Nil()),
 	)
 
+	f.Commentf("fetch%s requests and resolves %s entries matching the given query parameters", inflector.Pluralize(m.Name), m.Name)
 	f.Func().Params(
-		jen.Id("it").Op("*").Id(fmt.Sprintf("%sIterator", m.Name)),
-	).Id("fetch").Params().Id("error").Block(
-		jen.Id("c").Op(":=").Id("it.c"),
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("fetch%s", inflector.Pluralize(m.Name))).Params(
+		jen.Id("params").String(),
+		jen.Id("cache").Op("*").Id("iteratorCache"),
+	).Params(
+		jen.Index().Op("*").Id(m.Name), jen.Int(), jen.Id("error"),
+	).Block(
+		jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("request%s", inflector.Pluralize(m.Name))).Call(jen.Id("cache.locale"), jen.Id("params")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Lit(0), jen.Err()),
+		),
+		jen.List(jen.Id("items"), jen.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("decode%s", inflector.Pluralize(m.Name))).Call(
+			jen.Id("data"),
+			jen.Id("cache"),
+		),
+		jen.Return(jen.Id("items"), jen.Id("data.Total"), jen.Err()),
+	)
+
+	f.Commentf("request%s requests a raw page of %s entries matching the given query parameters", inflector.Pluralize(m.Name), m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("request%s", inflector.Pluralize(m.Name))).Params(
+		jen.Id("locale").String(),
+		jen.Id("params").String(),
+	).Params(
+		jen.Op("*").Id(fmt.Sprintf("%sResponse", m.DowncasedName())), jen.Id("error"),
+	).Block(
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("locale")),
 		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
-			jen.Lit("%s/spaces/%s/entries?access_token=%s&content_type=%s&include=%d&locale=%s&limit=%d&skip=%d"),
+			jen.Lit("%s/spaces/%s/entries?access_token=%s&content_type=%s&locale=%s&%s"),
 			jen.Id("c.host"),
 			jen.Id("c.spaceID"),
 			jen.Id("c.authToken"),
 			jen.Lit(m.Sys.ID),
-			jen.Id("it.IncludeCount"),
-			jen.Id("c.Locale"),
-			jen.Id("it.Limit"),
-			jen.Id("it.Offset"),
+			jen.Id("localeQuery").Call(jen.Id("chain")),
+			jen.Id("params"),
 		),
-		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.client.Get").Call(jen.Id("url")),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
 		jen.If(jen.Err().Op("!=").Nil()).Block(
-			jen.Return(jen.Err()),
+			jen.Return(jen.Nil(), jen.Err()),
 		),
 		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
-			jen.Return(
-				jen.Qual("fmt", "Errorf").Call(
-					jen.Lit("Request failed: %s, %v"),
-					jen.Id("resp.Status"),
-					jen.Err(),
-				),
-			),
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
 		),
 		jen.Var().Id("data").Id(fmt.Sprintf("%sResponse", m.DowncasedName())),
 		jen.If(
-			jen.Err().Op(":=").Qual("encoding/json", "NewDecoder").Call(
-				jen.Id("resp.Body"),
-			).Dot("Decode").Call(jen.Op("&").Id("data")),
-			jen.Err().Op("!=").Nil(),
-		).Block(jen.Return(jen.Err())),
-		jen.If(
-			jen.Err().Op(":=").Id("resp").Dot("Body").Dot("Close").Call(),
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Id("chain"), jen.Op("&").Id("data")),
 			jen.Err().Op("!=").Nil(),
 		).Block(
-			jen.Return(jen.Err()),
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Op("&").Id("data"), jen.Nil()),
+	)
+
+	f.Commentf("decode%s resolves the %s entries of a raw response", inflector.Pluralize(m.Name), m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("decode%s", inflector.Pluralize(m.Name))).Params(
+		jen.Id("data").Op("*").Id(fmt.Sprintf("%sResponse", m.DowncasedName())),
+		jen.Id("cache").Op("*").Id("iteratorCache"),
+	).Params(
+		jen.Index().Op("*").Id(m.Name), jen.Id("error"),
+	).Block(
+		jen.Id("index").Op(":=").Id("newLinkIndex").Call(jen.Id("data.Items"), jen.Id("data.Includes")),
+		jen.If(jen.Id("c.fetchLinks")).Block(
+			jen.If(
+				jen.Err().Op(":=").Id("c.completeLinks").Call(jen.Id("index"), jen.Id("cache")),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
 		),
 		jen.Var().Id("items").Op("=").Make(jen.Index().Op("*").Id(m.Name), jen.Len(jen.Id("data.Items"))),
 		jen.For(jen.List(jen.Id("i"), jen.Id("raw")).Op(":=").Range().Id("data.Items")).Block(
@@ -328,20 +460,102 @@ func generateModelType(f *jen.File, m contentfulModel) {
 					jen.Id("&item.Fields"),
 				),
 				jen.Err().Op("!=").Nil(),
-			).Block(jen.Return(jen.Err())),
-			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id(m.Name).Values(
+			).Block(jen.Return(jen.Nil(), jen.Err())),
+			jen.Id("entry").Op(":=").Id(m.Name).Values(
 				merge(
-					generateModelResolvers(m, "data.Items", "data.Includes", "it.lookupCache", true),
+					generateModelResolvers(m, "index", "cache", true),
 					jen.Dict{
 						jen.Id("ID"): jen.Id("raw.Sys.ID"),
 					},
 				),
 			),
+			jen.Id("cache.shared").Dot("setEntry").Call(jen.Op("&").Id("raw")),
+			jen.Id("items").Index(jen.Id("i")).Op("=").Op("&").Id("entry"),
 		),
-		jen.Id("it.items").Op("=").Id("items"),
-		jen.Return(jen.Nil()),
+		jen.Return(jen.Id("items"), jen.Nil()),
+	)
+
+	f.Commentf("%s retrieves a single %s entry by its ID", m.Name, m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(m.Name).Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id(m.Name), jen.Id("error"),
+	).Block(
+		jen.Comment("entries in the client cache resolve without a request"),
+		jen.If(
+			jen.List(jen.Id("entry"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
+				jen.Id("id"),
+				jen.Id("newLinkIndex").Call(jen.Nil(), jen.Id("includes").Values()),
+				jen.Id("c").Dot("newCache").Call(),
+			),
+			jen.Id("ok"),
+		).Block(
+			jen.Return(jen.Op("&").Id("entry"), jen.Nil()),
+		),
+		jen.List(jen.Id("items"), jen.Id("_"), jen.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("fetch%s", inflector.Pluralize(m.Name))).Call(
+			jen.Qual("fmt", "Sprintf").Call(
+				jen.Lit("include=%d&limit=1&sys.id=%s"),
+				jen.Id("maxIncludeDepth"),
+				jen.Qual("net/url", "QueryEscape").Call(jen.Id("id")),
+			),
+			jen.Id("c").Dot("newCache").Call(),
+		),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Len(jen.Id("items")).Op("==").Lit(0)).Block(
+			jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"):          jen.Id("id"),
+				jen.Id("ContentType"): jen.Lit(m.Sys.ID),
+			})),
+		),
+		jen.Return(jen.Id("items").Index(jen.Lit(0)), jen.Nil()),
 	)
 
+	for _, field := range m.Fields {
+		if field.Type != "Symbol" || !field.Unique() {
+			continue
+		}
+		fieldName := fieldName(field)
+		f.Commentf("%sBy%s retrieves the single %s entry with the given %s. A *DuplicateError is returned if several entries share the value", m.Name, fieldName, m.Name, field.Name)
+		f.Func().Params(
+			jen.Id("c").Op("*").Id("ContentClient"),
+		).Id(fmt.Sprintf("%sBy%s", m.Name, fieldName)).Params(
+			jen.Id("v").String(),
+		).Params(
+			jen.Op("*").Id(m.Name), jen.Id("error"),
+		).Block(
+			jen.List(jen.Id("items"), jen.Id("_"), jen.Err()).Op(":=").Id("c").Dot(fmt.Sprintf("fetch%s", inflector.Pluralize(m.Name))).Call(
+				jen.Qual("fmt", "Sprintf").Call(
+					jen.Lit(fmt.Sprintf("include=%%d&limit=2&fields.%s=%%s", field.Name)),
+					jen.Id("maxIncludeDepth"),
+					jen.Qual("net/url", "QueryEscape").Call(jen.Id("v")),
+				),
+				jen.Id("c").Dot("newCache").Call(),
+			),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Err()),
+			),
+			jen.If(jen.Len(jen.Id("items")).Op("==").Lit(0)).Block(
+				jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+					jen.Id("ID"):          jen.Id("v"),
+					jen.Id("Field"):       jen.Lit(field.Name),
+					jen.Id("ContentType"): jen.Lit(m.Sys.ID),
+				})),
+			),
+			jen.If(jen.Len(jen.Id("items")).Op(">").Lit(1)).Block(
+				jen.Return(jen.Nil(), jen.Op("&").Id("DuplicateError").Values(jen.Dict{
+					jen.Id("Type"):  jen.Lit(m.Name),
+					jen.Id("Field"): jen.Lit(fieldName),
+					jen.Id("Value"): jen.Id("v"),
+				})),
+			),
+			jen.Return(jen.Id("items").Index(jen.Lit(0)), jen.Nil()),
+		)
+	}
+
 	description := m.Description
 	if len(strings.TrimSpace(m.Description)) == 0 {
 		description = "has no description in contentful"
@@ -362,152 +576,130 @@ func generateModelType(f *jen.File, m contentfulModel) {
 		jen.Id("Limit").Int().Tag(map[string]string{"json": "limit"}),
 		jen.Id("Items").Index().Id("includeEntry").Tag(map[string]string{"json": "items"}),
 		jen.Id("Includes").Id("includes").Tag(map[string]string{"json": "includes"}),
+		jen.Id("Errors").Index().Id("IncludeError").Tag(map[string]string{"json": "errors"}),
 	)
 
 	var codes = []jen.Code{}
 	var attrs = jen.Dict{}
-	generateModelLinkResolver(m, "items", "includes", "cache")(attrs)
-	for k, v := range attrs {
-		codes = append(codes, jen.Id("tmp").Op(".").Add(k).Op("=").Add(v).Op(";"))
+	generateModelLinkResolver(m, "index", "cache")(attrs)
+	for _, k := range sortedKeys(attrs) {
+		codes = append(codes, jen.Id("tmp").Op(".").Add(k).Op("=").Add(attrs[k]).Op(";"))
 	}
 
 	f.Func().Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Params(
 		jen.Id("entryID").String(),
-		jen.Id("items").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Id(m.Name).Block(
+	).Params(jen.Id(m.Name), jen.Bool()).Block(
 		jen.If(
 			jen.Id("v, ok").Op(":=").Id(fmt.Sprintf("cache.%ss", m.DowncasedName())).Index(jen.Id("entryID")),
 			jen.Id("ok"),
 		).Block(
-			jen.Return(jen.Op("*").Id("v")),
+			jen.Return(jen.Op("*").Id("v"), jen.True()),
+		),
+		jen.List(jen.Id("entry"), jen.Id("cached")).Op(":=").Id("cache.shared").Dot("entry").Call(jen.Id("entryID")),
+		jen.If(jen.Op("!").Id("cached")).Block(
+			jen.Id("entry").Op("=").Id("index.entries").Index(jen.Id("entryID")),
+		),
+		jen.If(jen.Id("entry").Op("==").Nil().Op("||").Id("entry.Sys.ContentType.Sys.ID").Op("!=").Lit(m.Sys.ID)).Block(
+			jen.Return(jen.Id(m.Name).Values(), jen.False()),
 		),
 		jen.Var().Id("item").Id(fmt.Sprintf("%sItem", m.DowncasedName())),
-		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Append(jen.Id("includes.Entries"), jen.Id("items..."))).Block(
-			jen.If(jen.Id("entry.Sys.ID").Op("==").Id("entryID")).Block(
-				jen.If(
-					jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(
-						jen.Op("*").Id("entry.Fields"),
-						jen.Op("&").Id("item.Fields"),
-					),
-					jen.Err().Op("!=").Nil(),
-				).Block(
-					jen.Return(jen.Id(m.Name).Values()),
-				),
-				jen.Var().Id("tmp").Op("=").Op("&").Id(m.Name).Values(
-					merge(
-						generateModelResolvers(m, "items", "includes", "cache", false),
-						jen.Dict{
-							jen.Id("ID"): jen.Id("entry.Sys.ID"),
-						},
-					),
-				),
-				jen.Id(fmt.Sprintf("cache.%ss", m.DowncasedName())).Index(jen.Id("entry.Sys.ID")).Op("=").Id("tmp"),
-				jen.Add(codes...),
-				jen.Return(jen.Op("*").Id("tmp")),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(
+				jen.Op("*").Id("entry.Fields"),
+				jen.Op("&").Id("item.Fields"),
+			),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Id(m.Name).Values(), jen.False()),
+		),
+		jen.Var().Id("tmp").Op("=").Op("&").Id(m.Name).Values(
+			merge(
+				generateModelResolvers(m, "index", "cache", false),
+				jen.Dict{
+					jen.Id("ID"): jen.Id("entry.Sys.ID"),
+				},
 			),
 		),
-		jen.Return(jen.Id(m.Name).Values()),
+		jen.Id(fmt.Sprintf("cache.%ss", m.DowncasedName())).Index(jen.Id("entry.Sys.ID")).Op("=").Id("tmp"),
+		jen.Add(codes...),
+		jen.If(jen.Op("!").Id("cached")).Block(
+			jen.Id("cache.shared").Dot("setEntry").Call(jen.Id("entry")),
+		),
+		jen.Return(jen.Op("*").Id("tmp"), jen.True()),
 	)
 
-	f.Func().Id(fmt.Sprintf("resolve%sPtr", m.CapitalizedName())).Params(
+	f.Func().Id(fmt.Sprintf("resolve%sRef", m.CapitalizedName())).Params(
 		jen.Id("entryID").String(),
-		jen.Id("items").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Op("*").Id(m.Name).Block(
-		jen.Var().Id("item").Op("=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
+	).Id("Ref").Types(jen.Id(m.Name)).Block(
+		jen.Id("ref").Op(":=").Id("Ref").Types(jen.Id(m.Name)).Values(jen.Dict{jen.Id("ID"): jen.Id("entryID")}),
+		jen.List(jen.Id("ref.Value"), jen.Id("ref.Resolved")).Op("=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
 			jen.Id("entryID"),
-			jen.Id("items"),
-			jen.Id("includes"),
+			jen.Id("index"),
 			jen.Id("cache"),
 		),
-		jen.Return(jen.Op("&").Id("item")),
+		jen.Return(jen.Id("ref")),
 	)
 
-	f.Func().Id(fmt.Sprintf("resolve%ssPtr", m.CapitalizedName())).Params(
-		jen.Id("ids").Id("entryIDs"),
-		jen.Id("its").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+	f.Func().Id(fmt.Sprintf("resolve%sPtr", m.CapitalizedName())).Params(
+		jen.Id("entryID").String(),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Index().Op("*").Id(m.Name).Block(
-		jen.Var().Id("items").Op("=").Id(fmt.Sprintf("resolve%ss", m.CapitalizedName())).Call(
-			jen.Id("ids"),
-			jen.Id("its"),
-			jen.Id("includes"),
-			jen.Id("cache"),
-		),
-		jen.Var().Id("ptrs").Index().Op("*").Id(m.Name),
-		jen.For(jen.Id("i").Op(":=").Range().Id("items")).Block(
-			jen.Id("ptrs").Op("=").Append(jen.Id("ptrs"), jen.Op("&").Id("items").Index(jen.Id("i"))),
+	).Id("Ref").Types(jen.Op("*").Id(m.Name)).Block(
+		jen.Id("ref").Op(":=").Id("Ref").Types(jen.Op("*").Id(m.Name)).Values(jen.Dict{jen.Id("ID"): jen.Id("entryID")}),
+		jen.If(
+			jen.List(jen.Id("item"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(
+				jen.Id("entryID"),
+				jen.Id("index"),
+				jen.Id("cache"),
+			),
+			jen.Id("ok"),
+		).Block(
+			jen.Id("ref.Value").Op("=").Op("&").Id("item"),
+			jen.Id("ref.Resolved").Op("=").True(),
 		),
-		jen.Return(jen.Id("ptrs")),
+		jen.Return(jen.Id("ref")),
 	)
 
-	var codess = []jen.Code{}
-	var attrss = jen.Dict{}
-	generateModelLinkResolver(m, "its", "includes", "cache")(attrss)
-	for k, v := range attrss {
-		codess = append(codess, jen.Id("tmp").Op(".").Add(k).Op("=").Add(v).Op(";"))
-	}
-	f.Func().Id(fmt.Sprintf("resolve%ss", m.CapitalizedName())).Params(
+	f.Func().Id(fmt.Sprintf("resolve%ssPtr", m.CapitalizedName())).Params(
 		jen.Id("ids").Id("entryIDs"),
-		jen.Id("its").Index().Id("includeEntry"),
-		jen.Id("includes").Id("includes"),
+		jen.Id("index").Op("*").Id("linkIndex"),
 		jen.Id("cache").Id("*iteratorCache"),
-	).Index().Id(m.Name).Block(
-		jen.Var().Id("items").Index().Id(m.Name),
-		jen.Id("entries").Op(":=").Append(jen.Id("includes.Entries"), jen.Id("its...")),
+	).Index().Id("Ref").Types(jen.Op("*").Id(m.Name)).Block(
+		jen.Var().Id("refs").Index().Id("Ref").Types(jen.Op("*").Id(m.Name)),
 		jen.For(jen.List(jen.Id("_"), jen.Id("entryID")).Op(":=").Range().Id("ids")).Block(
-			jen.Var().Id("item").Id(fmt.Sprintf("%sItem", m.DowncasedName())),
-			jen.Var().Id("entry").Op("*").Id("includeEntry"),
-			jen.For(jen.List(jen.Id("_"), jen.Id("e")).Op(":=").Range().Id("entries")).Block(
-				jen.If(jen.Id("e.Sys.ID").Op("==").Id("entryID.Sys.ID")).Block(
-					jen.Id("entry").Op("=").Op("&").Id("e"),
-					jen.Break(),
-				),
-			),
-			jen.If(jen.Id("entry").Op("==").Nil()).Block(
-				jen.Continue(),
-			),
-			jen.If(
-				jen.Id("v, ok").Op(":=").Id(fmt.Sprintf("cache.%ss", m.DowncasedName())).Index(jen.Id("entry.Sys.ID")),
-				jen.Id("ok"),
-			).Block(
-				jen.Id("items").Op("=").Append(
-					jen.Id("items"),
-					jen.Op("*").Id("v"),
+			jen.Id("refs").Op("=").Append(
+				jen.Id("refs"),
+				jen.Id(fmt.Sprintf("resolve%sPtr", m.CapitalizedName())).Call(
+					jen.Id("entryID.Sys.ID"),
+					jen.Id("index"),
+					jen.Id("cache"),
 				),
-				jen.Continue(),
-			),
-
-			jen.If(
-				jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(
-					jen.Op("*").Id("entry.Fields"),
-					jen.Op("&").Id("item.Fields"),
-				),
-				jen.Err().Op("!=").Nil(),
-			).Block(
-				jen.Return(jen.Id("items")),
 			),
+		),
+		jen.Return(jen.Id("refs")),
+	)
 
-			jen.Var().Id("tmp").Op("=").Op("&").Id(m.Name).Values(
-				merge(
-					generateModelResolvers(m, "its", "includes", "cache", false),
-					jen.Dict{
-						jen.Id("ID"): jen.Id("entry.Sys.ID"),
-					},
+	f.Func().Id(fmt.Sprintf("resolve%ss", m.CapitalizedName())).Params(
+		jen.Id("ids").Id("entryIDs"),
+		jen.Id("index").Op("*").Id("linkIndex"),
+		jen.Id("cache").Id("*iteratorCache"),
+	).Index().Id("Ref").Types(jen.Id(m.Name)).Block(
+		jen.Var().Id("refs").Index().Id("Ref").Types(jen.Id(m.Name)),
+		jen.For(jen.List(jen.Id("_"), jen.Id("entryID")).Op(":=").Range().Id("ids")).Block(
+			jen.Id("refs").Op("=").Append(
+				jen.Id("refs"),
+				jen.Id(fmt.Sprintf("resolve%sRef", m.CapitalizedName())).Call(
+					jen.Id("entryID.Sys.ID"),
+					jen.Id("index"),
+					jen.Id("cache"),
 				),
 			),
-			jen.Id(fmt.Sprintf("cache.%ss", m.DowncasedName())).Index(jen.Id("entry.Sys.ID")).Op("=").Id("tmp"),
-			jen.Add(codess...),
-			jen.Id("items").Op("=").Append(
-				jen.Id("items"),
-				jen.Id("*tmp"),
-			),
 		),
-		jen.Return(jen.Id("items")),
+		jen.Return(jen.Id("refs")),
 	)
 
 	resolverName := inflector.Pluralize(m.Name)
@@ -520,18 +712,24 @@ func generateModelType(f *jen.File, m contentfulModel) {
 		jen.If(jen.Id("opts.Limit").Op("<=").Lit(0)).Block(
 			jen.Id("opts.Limit").Op("=").Lit(100),
 		),
+		jen.If(jen.Id("opts.Locale").Op("==").Lit("")).Block(
+			jen.Id("opts.Locale").Op("=").Id("c.Locale"),
+		),
 
 		jen.Id("it").Op(":=").Op("&").Id(fmt.Sprintf("%sIterator", m.Name)).Values(jen.Dict{
-			jen.Id("Page"):         jen.Id("opts.Page"),
 			jen.Id("Limit"):        jen.Id("opts.Limit"),
+			jen.Id("Offset"):       jen.Id("opts.Page").Op("*").Id("opts.Limit"),
 			jen.Id("IncludeCount"): jen.Id("opts.IncludeCount"),
 			jen.Id("c"):            jen.Id("c"),
-			jen.Id("lookupCache"): jen.Op("&").Id("iteratorCache").Values(jen.DictFunc(func(d jen.Dict) {
-				for _, m := range models {
-					d[jen.Id(fmt.Sprintf("%ss", m.DowncasedName()))] = jen.Make(jen.Map(jen.String()).Op("*").Id(m.Name))
-				}
-			})),
+			jen.Id("lookupCache"):  jen.Id("c").Dot("newLocaleCache").Call(jen.Id("opts.Locale")),
+			jen.Id("locale"):       jen.Id("opts.Locale"),
+			jen.Id("query"):        jen.Id(fmt.Sprintf("%sQuery", m.Name)).Call().Dot("encode").Call(),
 		}),
+		jen.If(jen.Id("opts.Prefetch").Op(">").Lit(0)).Block(
+			jen.Id("it.prefetch").Op("=").Op("&").Id("prefetcher").Types(jen.Op("*").Id(fmt.Sprintf("%sResponse", m.DowncasedName()))).Values(jen.Dict{
+				jen.Id("pages"): jen.Id("opts.Prefetch"),
+			}),
+		),
 		jen.Return(jen.Id("it")),
 	)
 }
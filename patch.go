@@ -0,0 +1,219 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+// patchValue returns the parameter type of a single patch value of a field and the value sent for it.
+// Links are passed as IDs and sent as Link
+func patchValue(typ, linkType string) (jen.Code, func(jen.Code) jen.Code, bool) {
+	same := func(v jen.Code) jen.Code { return v }
+	switch typ {
+	case "Symbol", "Text":
+		return jen.String(), same, true
+	case "Integer":
+		return jen.Int64(), same, true
+	case "Number":
+		return jen.Float64(), same, true
+	case "Boolean":
+		return jen.Bool(), same, true
+	case "Date":
+		return jen.Id("Date"), same, true
+	case "Link":
+		return jen.String(), func(v jen.Code) jen.Code {
+			return jen.Id(fmt.Sprintf("%sLink", linkType)).Call(v)
+		}, true
+	}
+	return nil, nil, false
+}
+
+func generatePatchUtils(f *jen.File) {
+	f.Comment("patchOperation is a single JSON Patch operation")
+	f.Type().Id("patchOperation").Struct(
+		jen.Id("Op").String().Tag(map[string]string{"json": "op"}),
+		jen.Id("Path").String().Tag(map[string]string{"json": "path"}),
+		jen.Id("Value").Interface().Tag(map[string]string{"json": "value,omitempty"}),
+		jen.List(jen.Id("field"), jen.Id("locale")).String(),
+	)
+
+	f.Comment("patchBuilder collects the JSON Patch operations shared by all generated patch builders")
+	f.Type().Id("patchBuilder").Struct(
+		jen.Id("ops").Index().Id("patchOperation"),
+	)
+
+	f.Func().Params(
+		jen.Id("p").Op("*").Id("patchBuilder"),
+	).Id("add").Params(
+		jen.List(jen.Id("op"), jen.Id("field"), jen.Id("locale"), jen.Id("suffix")).String(),
+		jen.Id("value").Interface(),
+	).Block(
+		jen.Id("p.ops").Op("=").Append(jen.Id("p.ops"), jen.Id("patchOperation").Values(jen.Dict{
+			jen.Id("Op"):     jen.Id("op"),
+			jen.Id("Path"):   jen.Id("fieldPath").Call(jen.Id("field"), jen.Id("locale")).Op("+").Id("suffix"),
+			jen.Id("Value"):  jen.Id("value"),
+			jen.Id("field"):  jen.Id("field"),
+			jen.Id("locale"): jen.Id("locale"),
+		})),
+	)
+
+	f.Comment("operations returns the operations of p for an entry with fields. JSON Patch cannot add to a missing parent, so a field or locale that is not set yet is created with the first value added to it")
+	f.Func().Params(
+		jen.Id("p").Op("*").Id("patchBuilder"),
+	).Id("operations").Params(
+		jen.Id("fields").Interface(),
+	).Params(
+		jen.Index().Id("patchOperation"), jen.Error(),
+	).Block(
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("fields")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("set").Op(":=").Map(jen.String()).Map(jen.String()).Qual("encoding/json", "RawMessage").Values(),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("set")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Id("ops").Op(":=").Make(jen.Index().Id("patchOperation"), jen.Lit(0), jen.Len(jen.Id("p.ops"))),
+		jen.For(jen.List(jen.Id("_"), jen.Id("op")).Op(":=").Range().Id("p.ops")).Block(
+			jen.List(jen.Id("locales"), jen.Id("ok")).Op(":=").Id("set").Index(jen.Id("op.field")),
+			jen.If(jen.Id("op.Op").Op("==").Lit("remove")).Block(
+				jen.Delete(jen.Id("locales"), jen.Id("op.locale")),
+				jen.Id("ops").Op("=").Append(jen.Id("ops"), jen.Id("op")),
+				jen.Continue(),
+			),
+			jen.Id("value").Op(":=").Id("op.Value"),
+			jen.If(jen.Qual("strings", "HasSuffix").Call(jen.Id("op.Path"), jen.Lit("/-"))).Block(
+				jen.If(
+					jen.List(jen.Id("_"), jen.Id("exists")).Op(":=").Id("locales").Index(jen.Id("op.locale")),
+					jen.Op("!").Id("exists"),
+				).Block(
+					jen.Id("value").Op("=").Index().Interface().Values(jen.Id("op.Value")),
+					jen.Id("op.Path").Op("=").Id("fieldPath").Call(jen.Id("op.field"), jen.Id("op.locale")),
+					jen.Id("op.Value").Op("=").Id("value"),
+				),
+			),
+			jen.If(jen.Op("!").Id("ok")).Block(
+				jen.Id("locales").Op("=").Map(jen.String()).Qual("encoding/json", "RawMessage").Values(),
+				jen.Id("set").Index(jen.Id("op.field")).Op("=").Id("locales"),
+				jen.Id("op.Path").Op("=").Lit("/fields/").Op("+").Id("op.field"),
+				jen.Id("op.Value").Op("=").Map(jen.String()).Interface().Values(jen.Dict{
+					jen.Id("op.locale"): jen.Id("value"),
+				}),
+			),
+			jen.Id("locales").Index(jen.Id("op.locale")).Op("=").Nil(),
+			jen.Id("ops").Op("=").Append(jen.Id("ops"), jen.Id("op")),
+		),
+		jen.Return(jen.Id("ops"), jen.Nil()),
+	)
+
+	f.Comment("fieldPath returns the JSON Pointer of a localized field value")
+	f.Func().Id("fieldPath").Params(
+		jen.List(jen.Id("field"), jen.Id("locale")).String(),
+	).String().Block(
+		jen.Id("escape").Op(":=").Qual("strings", "NewReplacer").Call(jen.Lit("~"), jen.Lit("~0"), jen.Lit("/"), jen.Lit("~1")),
+		jen.Return(jen.Lit("/fields/").Op("+").Id("field").Op("+").Lit("/").Op("+").Id("escape").Dot("Replace").Call(jen.Id("locale"))),
+	)
+
+	for _, m := range models {
+		generatePatchBuilder(f, m)
+	}
+}
+
+func generatePatchBuilder(f *jen.File, m contentfulModel) {
+	builder := fmt.Sprintf("%sPatchBuilder", m.Name)
+	entry := fmt.Sprintf("%sEntry", m.Name)
+	service := fmt.Sprintf("%sEntryService", m.Name)
+
+	f.Commentf("%s builds JSON Patch updates of %s entries", builder, m.Name)
+	f.Type().Id(builder).Struct(
+		jen.Id("patchBuilder"),
+	)
+
+	f.Commentf("%sPatch returns an empty patch for %s entries", m.Name, m.Name)
+	f.Func().Id(fmt.Sprintf("%sPatch", m.Name)).Params().Op("*").Id(builder).Block(
+		jen.Return(jen.Op("&").Id(builder).Values()),
+	)
+
+	method := func(comment, name string, params []jen.Code, body ...jen.Code) {
+		f.Comment(comment)
+		f.Func().Params(
+			jen.Id("p").Op("*").Id(builder),
+		).Id(name).Params(append([]jen.Code{jen.Id("locale").String()}, params...)...).Op("*").Id(builder).Block(
+			append(body, jen.Return(jen.Id("p")))...,
+		)
+	}
+	path := func(field field, suffix string) jen.Code {
+		return jen.List(jen.Lit(field.Name), jen.Id("locale"), jen.Lit(suffix))
+	}
+
+	for _, field := range m.Fields {
+		name := fieldName(field)
+		if field.Type == "Array" {
+			typ, value, ok := patchValue(field.Items.Type, field.Items.LinkType)
+			if !ok {
+				continue
+			}
+			single := inflector.Singularize(name)
+			method(
+				fmt.Sprintf("Set%s replaces all values of %s in a locale", name, field.Name),
+				fmt.Sprintf("Set%s", name),
+				[]jen.Code{jen.Id("vs").Op("...").Add(typ)},
+				jen.Id("values").Op(":=").Make(jen.Index().Interface(), jen.Len(jen.Id("vs"))),
+				jen.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Id("vs")).Block(
+					jen.Id("values").Index(jen.Id("i")).Op("=").Add(value(jen.Id("v"))),
+				),
+				jen.Id("p").Dot("add").Call(jen.Lit("add"), path(field, ""), jen.Id("values")),
+			)
+			method(
+				fmt.Sprintf("Add%s appends a value to %s in a locale", single, field.Name),
+				fmt.Sprintf("Add%s", single),
+				[]jen.Code{jen.Id("v").Add(typ)},
+				jen.Id("p").Dot("add").Call(jen.Lit("add"), path(field, "/-"), value(jen.Id("v"))),
+			)
+		} else {
+			typ, value, ok := patchValue(field.Type, field.LinkType)
+			if !ok {
+				continue
+			}
+			method(
+				fmt.Sprintf("Set%s sets %s in a locale", name, field.Name),
+				fmt.Sprintf("Set%s", name),
+				[]jen.Code{jen.Id("v").Add(typ)},
+				jen.Id("p").Dot("add").Call(jen.Lit("add"), path(field, ""), value(jen.Id("v"))),
+			)
+		}
+		method(
+			fmt.Sprintf("Remove%s removes %s in a locale", name, field.Name),
+			fmt.Sprintf("Remove%s", name),
+			nil,
+			jen.Id("p").Dot("add").Call(jen.Lit("remove"), path(field, ""), jen.Nil()),
+		)
+	}
+
+	f.Commentf("Patch applies the operations of p to a %s entry. It fails if the entry changed since e.Version. Fields missing from e.Fields are created by the patch, so e should hold the entry as returned by the api", m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id(service),
+	).Id("Patch").Params(
+		jen.Id("e").Op("*").Id(entry),
+		jen.Id("p").Op("*").Id(builder),
+	).Error().Block(
+		jen.List(jen.Id("ops"), jen.Err()).Op(":=").Id("p").Dot("operations").Call(jen.Id("e.Fields")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("header").Op(":=").Id("versionHeader").Call(jen.Id("e.Version")),
+		jen.Id("header").Dot("Set").Call(jen.Lit("Content-Type"), jen.Lit("application/json-patch+json")),
+		jen.Return(jen.Id("s").Dot("send").Call(
+			jen.Lit("PATCH"),
+			jen.Lit("/entries/").Op("+").Qual("net/url", "PathEscape").Call(jen.Id("e.ID")),
+			jen.Id("header"),
+			jen.Id("e"),
+			jen.Id("ops"),
+		)),
+	)
+}
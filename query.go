@@ -0,0 +1,269 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+// queryValue describes how a go parameter of a field type is formatted as a query value
+type queryValue struct {
+	typ    jen.Code
+	plain  bool
+	format func(jen.Code) jen.Code
+}
+
+var (
+	stringQueryValue = queryValue{
+		typ:    jen.String(),
+		plain:  true,
+		format: func(v jen.Code) jen.Code { return v },
+	}
+	integerQueryValue = queryValue{
+		typ: jen.Int64(),
+		format: func(v jen.Code) jen.Code {
+			return jen.Qual("strconv", "FormatInt").Call(v, jen.Lit(10))
+		},
+	}
+	numberQueryValue = queryValue{
+		typ: jen.Float64(),
+		format: func(v jen.Code) jen.Code {
+			return jen.Qual("strconv", "FormatFloat").Call(v, jen.LitByte('f'), jen.Lit(-1), jen.Lit(64))
+		},
+	}
+	booleanQueryValue = queryValue{
+		typ: jen.Bool(),
+		format: func(v jen.Code) jen.Code {
+			return jen.Qual("strconv", "FormatBool").Call(v)
+		},
+	}
+	dateQueryValue = queryValue{
+		typ: jen.Qual("time", "Time"),
+		format: func(v jen.Code) jen.Code {
+			return jen.Add(v).Dot("Format").Call(jen.Qual("time", "RFC3339"))
+		},
+	}
+)
+
+func generateQueryUtils(f *jen.File) {
+	f.Comment("defaultOrder keeps pagination stable when entries are added or removed mid iteration")
+	f.Const().Id("defaultOrder").Op("=").Lit("sys.createdAt,sys.id")
+
+	f.Comment("queryBuilder collects search parameters shared by all generated query builders")
+	f.Type().Id("queryBuilder").Struct(
+		jen.Id("values").Qual("net/url", "Values"),
+		jen.Id("order").Index().String(),
+		jen.Id("fields").Index().String(),
+	)
+
+	f.Func().Params(
+		jen.Id("q").Op("*").Id("queryBuilder"),
+	).Id("set").Params(
+		jen.Id("key").String(),
+		jen.Id("value").String(),
+	).Block(
+		jen.If(jen.Id("q.values").Op("==").Nil()).Block(
+			jen.Id("q.values").Op("=").Qual("net/url", "Values").Values(),
+		),
+		jen.Id("q.values").Dot("Set").Call(jen.Id("key"), jen.Id("value")),
+	)
+
+	f.Func().Params(
+		jen.Id("q").Op("*").Id("queryBuilder"),
+	).Id("encode").Params().String().Block(
+		jen.Id("values").Op(":=").Qual("net/url", "Values").Values(),
+		jen.For(jen.List(jen.Id("k"), jen.Id("v")).Op(":=").Range().Id("q.values")).Block(
+			jen.Id("values").Index(jen.Id("k")).Op("=").Id("v"),
+		),
+		jen.Id("order").Op(":=").Id("defaultOrder"),
+		jen.If(jen.Len(jen.Id("q.order")).Op(">").Lit(0)).Block(
+			jen.Id("order").Op("=").Qual("strings", "Join").Call(jen.Id("q.order"), jen.Lit(",")),
+			jen.Comment("sys.id breaks ties so pages don't overlap when sorting by non-unique fields"),
+			jen.If(jen.Op("!").Qual("strings", "Contains").Call(jen.Id("order"), jen.Lit("sys.id"))).Block(
+				jen.Id("order").Op("+=").Lit(",sys.id"),
+			),
+		),
+		jen.Id("values").Dot("Set").Call(jen.Lit("order"), jen.Id("order")),
+		jen.If(jen.Len(jen.Id("q.fields")).Op(">").Lit(0)).Block(
+			jen.Id("values").Dot("Set").Call(jen.Lit("select"), jen.Qual("strings", "Join").Call(
+				jen.Append(jen.Index().String().Values(jen.Lit("sys")), jen.Id("q.fields...")),
+				jen.Lit(","),
+			)),
+		),
+		jen.Return(jen.Id("values").Dot("Encode").Call()),
+	)
+}
+
+func generateQueryBuilder(f *jen.File, m contentfulModel) {
+	builder := fmt.Sprintf("%sQueryBuilder", m.Name)
+	fieldType := fmt.Sprintf("%sField", m.Name)
+
+	f.Commentf("%s names a %s field which can be selected in queries", fieldType, m.Name)
+	f.Type().Id(fieldType).String()
+
+	f.Const().DefsFunc(func(g *jen.Group) {
+		for _, field := range m.Fields {
+			g.Id(fmt.Sprintf("%s%s", fieldType, fieldName(field))).Id(fieldType).Op("=").Lit(fmt.Sprintf("fields.%s", field.Name))
+		}
+	})
+
+	f.Commentf("%s builds filtered and ordered queries for %s entries", builder, m.Name)
+	f.Type().Id(builder).Struct(
+		jen.Id("queryBuilder"),
+	)
+
+	f.Commentf("%sQuery returns an empty query for %s entries", m.Name, m.Name)
+	f.Func().Id(fmt.Sprintf("%sQuery", m.Name)).Params().Op("*").Id(builder).Block(
+		jen.Return(jen.Op("&").Id(builder).Values()),
+	)
+
+	method := func(comment, name string, params []jen.Code, body ...jen.Code) {
+		f.Comment(comment)
+		f.Func().Params(
+			jen.Id("q").Op("*").Id(builder),
+		).Id(name).Params(params...).Op("*").Id(builder).Block(
+			append(body, jen.Return(jen.Id("q")))...,
+		)
+	}
+	set := func(key string, value jen.Code) jen.Code {
+		return jen.Id("q").Dot("set").Call(jen.Lit(key), value)
+	}
+	join := func(key string, v queryValue) []jen.Code {
+		if v.plain {
+			return []jen.Code{set(key, jen.Qual("strings", "Join").Call(jen.Id("vs"), jen.Lit(",")))}
+		}
+		return []jen.Code{
+			jen.Id("values").Op(":=").Make(jen.Index().String(), jen.Len(jen.Id("vs"))),
+			jen.For(jen.List(jen.Id("i"), jen.Id("v")).Op(":=").Range().Id("vs")).Block(
+				jen.Id("values").Index(jen.Id("i")).Op("=").Add(v.format(jen.Id("v"))),
+			),
+			set(key, jen.Qual("strings", "Join").Call(jen.Id("values"), jen.Lit(","))),
+		}
+	}
+	comparison := func(name, key, op, description string, v queryValue) {
+		method(
+			fmt.Sprintf("%s%s %s", name, op, description),
+			fmt.Sprintf("%s%s", name, op),
+			[]jen.Code{jen.Id("v").Add(v.typ)},
+			set(key, v.format(jen.Id("v"))),
+		)
+	}
+	list := func(name, key, op, description string, v queryValue) {
+		method(
+			fmt.Sprintf("%s%s %s", name, op, description),
+			fmt.Sprintf("%s%s", name, op),
+			[]jen.Code{jen.Id("vs").Op("...").Add(v.typ)},
+			join(key, v)...,
+		)
+	}
+	exists := func(name, key string) {
+		method(
+			fmt.Sprintf("%sExists filters entries by whether %s is set", name, name),
+			fmt.Sprintf("%sExists", name),
+			[]jen.Code{jen.Id("v").Bool()},
+			set(key+"[exists]", booleanQueryValue.format(jen.Id("v"))),
+		)
+	}
+	order := func(name, key string) {
+		method(
+			fmt.Sprintf("OrderBy%sAsc sorts entries by %s in ascending order", name, name),
+			fmt.Sprintf("OrderBy%sAsc", name),
+			nil,
+			jen.Id("q.order").Op("=").Append(jen.Id("q.order"), jen.Lit(key)),
+		)
+		method(
+			fmt.Sprintf("OrderBy%sDesc sorts entries by %s in descending order", name, name),
+			fmt.Sprintf("OrderBy%sDesc", name),
+			nil,
+			jen.Id("q.order").Op("=").Append(jen.Id("q.order"), jen.Lit("-"+key)),
+		)
+	}
+	ordered := func(name, key string, v queryValue) {
+		comparison(name, key, "Equals", "filters entries by an exact value", v)
+		comparison(name, key+"[ne]", "NotEquals", "excludes entries with the given value", v)
+		list(name, key+"[in]", "In", "filters entries matching any of the given values", v)
+		list(name, key+"[nin]", "NotIn", "excludes entries matching any of the given values", v)
+		comparison(name, key+"[lt]", "LT", "filters entries with a value less than the given one", v)
+		comparison(name, key+"[lte]", "LTE", "filters entries with a value less than or equal to the given one", v)
+		comparison(name, key+"[gt]", "GT", "filters entries with a value greater than the given one", v)
+		comparison(name, key+"[gte]", "GTE", "filters entries with a value greater than or equal to the given one", v)
+		exists(name, key)
+		order(name, key)
+	}
+
+	method(
+		"Search filters entries by a full text search across all text fields",
+		"Search",
+		[]jen.Code{jen.Id("text").String()},
+		set("query", jen.Id("text")),
+	)
+	method(
+		fmt.Sprintf("Select restricts the returned %s fields", m.Name),
+		"Select",
+		[]jen.Code{jen.Id("fields").Op("...").Id(fieldType)},
+		jen.For(jen.List(jen.Id("_"), jen.Id("field")).Op(":=").Range().Id("fields")).Block(
+			jen.Id("q.fields").Op("=").Append(jen.Id("q.fields"), jen.String().Call(jen.Id("field"))),
+		),
+	)
+	order("CreatedAt", "sys.createdAt")
+	order("UpdatedAt", "sys.updatedAt")
+
+	for _, field := range m.Fields {
+		name := fieldName(field)
+		key := fmt.Sprintf("fields.%s", field.Name)
+		switch field.Type {
+		case "Symbol":
+			comparison(name, key, "Equals", "filters entries by an exact value", stringQueryValue)
+			comparison(name, key+"[ne]", "NotEquals", "excludes entries with the given value", stringQueryValue)
+			list(name, key+"[in]", "In", "filters entries matching any of the given values", stringQueryValue)
+			list(name, key+"[nin]", "NotIn", "excludes entries matching any of the given values", stringQueryValue)
+			comparison(name, key+"[match]", "Match", "filters entries by a full text search on this field", stringQueryValue)
+			exists(name, key)
+			order(name, key)
+		case "Text":
+			comparison(name, key+"[match]", "Match", "filters entries by a full text search on this field", stringQueryValue)
+			exists(name, key)
+		case "Integer":
+			ordered(name, key, integerQueryValue)
+		case "Number":
+			ordered(name, key, numberQueryValue)
+		case "Date":
+			ordered(name, key, dateQueryValue)
+		case "Boolean":
+			comparison(name, key, "Equals", "filters entries by an exact value", booleanQueryValue)
+			exists(name, key)
+			order(name, key)
+		case "Link":
+			comparison(name, key+".sys.id", "IDEquals", "filters entries linking to the given ID", stringQueryValue)
+			list(name, key+".sys.id[in]", "IDIn", "filters entries linking to any of the given IDs", stringQueryValue)
+			exists(name, key)
+		case "Array":
+			switch field.Items.Type {
+			case "Symbol":
+				comparison(name, key, "Contains", "filters entries containing the given value", stringQueryValue)
+				list(name, key+"[in]", "ContainsAny", "filters entries containing any of the given values", stringQueryValue)
+				list(name, key+"[all]", "ContainsAll", "filters entries containing all of the given values", stringQueryValue)
+				list(name, key+"[nin]", "ContainsNone", "excludes entries containing any of the given values", stringQueryValue)
+				exists(name, key)
+			case "Link":
+				comparison(name, key+".sys.id", "Contains", "filters entries linking to the given ID", stringQueryValue)
+				list(name, key+".sys.id[in]", "ContainsAny", "filters entries linking to any of the given IDs", stringQueryValue)
+				exists(name, key)
+			}
+		}
+	}
+
+	resolverName := inflector.Pluralize(m.Name)
+	f.Commentf("Query%s retrieves paginated %s entries matching the given query", resolverName, m.Name)
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id(fmt.Sprintf("Query%s", resolverName)).Params(
+		jen.Id("q").Op("*").Id(builder),
+		jen.Id("opts").Id("ListOptions"),
+	).Op("*").Id(fmt.Sprintf("%sIterator", m.Name)).Block(
+		jen.Id("it").Op(":=").Id("c").Dot(resolverName).Call(jen.Id("opts")),
+		jen.Id("it.query").Op("=").Id("q").Dot("encode").Call(),
+		jen.Return(jen.Id("it")),
+	)
+}
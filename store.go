@@ -0,0 +1,319 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+func generateStore(f *jen.File) {
+	f.Comment("Store keeps all entries and assets of a space in memory. It is filled and updated through the sync api and resolves links across the whole space. Store is safe for concurrent use")
+	f.Type().Id("Store").Struct(
+		jen.Id("c").Op("*").Id("ContentClient"),
+		jen.Id("mu").Qual("sync", "RWMutex"),
+		jen.Id("token").String(),
+		jen.Id("index").Op("*").Id("linkIndex"),
+		jen.Id("unique").Map(jen.String()).Map(jen.String()).Index().String(),
+	)
+
+	f.Comment("storeSnapshot is the file representation of a Store")
+	f.Type().Id("storeSnapshot").Struct(
+		jen.Id("Locale").String().Tag(map[string]string{"json": "locale"}),
+		jen.Id("Token").String().Tag(map[string]string{"json": "token"}),
+		jen.Id("Entries").Index().Op("*").Id("includeEntry").Tag(map[string]string{"json": "entries"}),
+		jen.Id("Assets").Index().Op("*").Id("includeAsset").Tag(map[string]string{"json": "assets"}),
+	)
+
+	f.Comment("NewStore returns an empty store. Call Sync or Restore to fill it")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("NewStore").Params().Op("*").Id("Store").Block(
+		jen.Return(jen.Op("&").Id("Store").Values(jen.Dict{
+			jen.Id("c"):      jen.Id("c"),
+			jen.Id("index"):  jen.Id("newLinkIndex").Call(jen.Nil(), jen.Id("includes").Values()),
+			jen.Id("unique"): jen.Map(jen.String()).Map(jen.String()).Index().String().Values(),
+		})),
+	)
+
+	f.Comment("Sync applies all changes since the previous sync, or the entire space on the first call, to the store")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id("Sync").Params().Error().Block(
+		jen.Id("s.mu").Dot("RLock").Call(),
+		jen.Id("token").Op(":=").Id("s.token"),
+		jen.Id("s.mu").Dot("RUnlock").Call(),
+		jen.List(jen.Id("entries"), jen.Id("assets"), jen.Id("result"), jen.Err()).Op(":=").Id("s.c.syncPages").Call(jen.Id("token")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Defer().Id("s.mu").Dot("Unlock").Call(),
+		jen.For(jen.Id("i").Op(":=").Range().Id("entries")).Block(
+			jen.Id("s.index.entries").Index(jen.Id("entries").Index(jen.Id("i")).Dot("Sys.ID")).Op("=").Op("&").Id("entries").Index(jen.Id("i")),
+		),
+		jen.For(jen.Id("i").Op(":=").Range().Id("assets")).Block(
+			jen.Id("s.index.assets").Index(jen.Id("assets").Index(jen.Id("i")).Dot("Sys.ID")).Op("=").Op("&").Id("assets").Index(jen.Id("i")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("result.DeletedEntries")).Block(
+			jen.Delete(jen.Id("s.index.entries"), jen.Id("id")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("result.DeletedAssets")).Block(
+			jen.Delete(jen.Id("s.index.assets"), jen.Id("id")),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("s").Dot("reindex").Call(),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Id("s.token").Op("=").Id("result.Token"),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("reindex rebuilds the lookup tables of unique fields. Values shared by several entries are kept so lookups can report them. The caller must hold the write lock")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id("reindex").Params().Error().BlockFunc(func(g *jen.Group) {
+		g.Id("s.unique").Op("=").Map(jen.String()).Map(jen.String()).Index().String().Values()
+		for _, m := range models {
+			for _, field := range m.Fields {
+				if field.Type == "Symbol" && field.Unique() {
+					g.Id("s.unique").Index(jen.Lit(fmt.Sprintf("%s.%s", m.Sys.ID, field.Name))).Op("=").Map(jen.String()).Index().String().Values()
+				}
+			}
+		}
+		g.For(jen.List(jen.Id("id"), jen.Id("entry")).Op(":=").Range().Id("s.index.entries")).Block(
+			jen.Switch(jen.Id("entry.Sys.ContentType.Sys.ID")).BlockFunc(func(g *jen.Group) {
+				for _, m := range models {
+					var unique []field
+					for _, field := range m.Fields {
+						if field.Type == "Symbol" && field.Unique() {
+							unique = append(unique, field)
+						}
+					}
+					if len(unique) == 0 {
+						continue
+					}
+					g.Case(jen.Lit(m.Sys.ID)).BlockFunc(func(g *jen.Group) {
+						g.Var().Id("item").Id(fmt.Sprintf("%sItem", m.DowncasedName()))
+						g.If(
+							jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Op("*").Id("entry.Fields"), jen.Op("&").Id("item.Fields")),
+							jen.Err().Op("!=").Nil(),
+						).Block(
+							jen.Return(jen.Err()),
+						)
+						for _, field := range unique {
+							index := jen.Id("s.unique").Index(jen.Lit(fmt.Sprintf("%s.%s", m.Sys.ID, field.Name))).Index(jen.Id("item.Fields").Dot(fieldName(field)))
+							g.Add(index).Op("=").Append(index, jen.Id("id"))
+						}
+					})
+				}
+			}),
+		)
+		g.Return(jen.Nil())
+	})
+
+	f.Comment("Snapshot writes the contents of the store to a file, replacing it atomically")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id("Snapshot").Params(
+		jen.Id("path").String(),
+	).Error().Block(
+		jen.Id("s.mu").Dot("RLock").Call(),
+		jen.Id("snapshot").Op(":=").Id("storeSnapshot").Values(jen.Dict{
+			jen.Id("Locale"): jen.Id("s.c.Locale"),
+			jen.Id("Token"):  jen.Id("s.token"),
+		}),
+		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("s.index.entries")).Block(
+			jen.Id("snapshot.Entries").Op("=").Append(jen.Id("snapshot.Entries"), jen.Id("entry")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("asset")).Op(":=").Range().Id("s.index.assets")).Block(
+			jen.Id("snapshot.Assets").Op("=").Append(jen.Id("snapshot.Assets"), jen.Id("asset")),
+		),
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("encoding/json", "Marshal").Call(jen.Id("snapshot")),
+		jen.Id("s.mu").Dot("RUnlock").Call(),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.List(jen.Id("file"), jen.Err()).Op(":=").Qual("os", "CreateTemp").Call(jen.Qual("path/filepath", "Dir").Call(jen.Id("path")), jen.Qual("path/filepath", "Base").Call(jen.Id("path")).Op("+").Lit(".*")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(
+			jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("file").Dot("Write").Call(jen.Id("b")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Id("file").Dot("Close").Call(),
+			jen.Qual("os", "Remove").Call(jen.Id("file").Dot("Name").Call()),
+			jen.Return(jen.Err()),
+		),
+		jen.If(
+			jen.Err().Op(":=").Id("file").Dot("Close").Call(),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Qual("os", "Remove").Call(jen.Id("file").Dot("Name").Call()),
+			jen.Return(jen.Err()),
+		),
+		jen.Return(jen.Qual("os", "Rename").Call(jen.Id("file").Dot("Name").Call(), jen.Id("path"))),
+	)
+
+	f.Comment("Restore replaces the contents of the store with a snapshot. Later syncs continue from the snapshot")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id("Restore").Params(
+		jen.Id("path").String(),
+	).Error().Block(
+		jen.List(jen.Id("b"), jen.Err()).Op(":=").Qual("os", "ReadFile").Call(jen.Id("path")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.Var().Id("snapshot").Id("storeSnapshot"),
+		jen.If(
+			jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Id("b"), jen.Op("&").Id("snapshot")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Err()),
+		),
+		jen.If(jen.Id("snapshot.Locale").Op("!=").Id("s.c.Locale")).Block(
+			jen.Return(jen.Qual("fmt", "Errorf").Call(jen.Lit("snapshot locale %s does not match client locale %s"), jen.Id("snapshot.Locale"), jen.Id("s.c.Locale"))),
+		),
+		jen.Id("index").Op(":=").Id("newLinkIndex").Call(jen.Nil(), jen.Id("includes").Values()),
+		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("snapshot.Entries")).Block(
+			jen.Id("index.entries").Index(jen.Id("entry.Sys.ID")).Op("=").Id("entry"),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("asset")).Op(":=").Range().Id("snapshot.Assets")).Block(
+			jen.Id("index.assets").Index(jen.Id("asset.Sys.ID")).Op("=").Id("asset"),
+		),
+		jen.Id("s.mu").Dot("Lock").Call(),
+		jen.Defer().Id("s.mu").Dot("Unlock").Call(),
+		jen.List(jen.Id("previous"), jen.Id("unique")).Op(":=").List(jen.Id("s.index"), jen.Id("s.unique")),
+		jen.Id("s.index").Op("=").Id("index"),
+		jen.If(
+			jen.Err().Op(":=").Id("s").Dot("reindex").Call(),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.List(jen.Id("s.index"), jen.Id("s.unique")).Op("=").List(jen.Id("previous"), jen.Id("unique")),
+			jen.Return(jen.Err()),
+		),
+		jen.Id("s.token").Op("=").Id("snapshot.Token"),
+		jen.Return(jen.Nil()),
+	)
+
+	f.Comment("Asset returns a single Asset of the store")
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id("Asset").Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id("Asset"), jen.Error(),
+	).Block(
+		jen.Id("s.mu").Dot("RLock").Call(),
+		jen.Defer().Id("s.mu").Dot("RUnlock").Call(),
+		jen.List(jen.Id("raw"), jen.Id("ok")).Op(":=").Id("s.index.assets").Index(jen.Id("id")),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"): jen.Id("id"),
+			})),
+		),
+		jen.Id("asset").Op(":=").Id("toAsset").Call(jen.Op("*").Id("raw")),
+		jen.Return(jen.Op("&").Id("asset"), jen.Nil()),
+	)
+
+	for _, m := range models {
+		generateStoreModel(f, m)
+	}
+}
+
+func generateStoreModel(f *jen.File, m contentfulModel) {
+	f.Commentf("%s returns a single %s of the store with all links resolved", m.Name, m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id(m.Name).Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id(m.Name), jen.Error(),
+	).Block(
+		jen.Id("s.mu").Dot("RLock").Call(),
+		jen.Defer().Id("s.mu").Dot("RUnlock").Call(),
+		jen.Return(jen.Id("s").Dot(fmt.Sprintf("find%s", m.Name)).Call(jen.Id("id"))),
+	)
+
+	f.Commentf("find%s resolves a single %s of the store. The caller must hold the read lock", m.Name, m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id(fmt.Sprintf("find%s", m.Name)).Params(
+		jen.Id("id").String(),
+	).Params(
+		jen.Op("*").Id(m.Name), jen.Error(),
+	).Block(
+		jen.List(jen.Id("item"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(jen.Id("id"), jen.Id("s.index"), jen.Id("newIteratorCache").Call()),
+		jen.If(jen.Op("!").Id("ok")).Block(
+			jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+				jen.Id("ID"):          jen.Id("id"),
+				jen.Id("ContentType"): jen.Lit(m.Sys.ID),
+			})),
+		),
+		jen.Return(jen.Op("&").Id("item"), jen.Nil()),
+	)
+
+	f.Commentf("%s returns all %s entries of the store ordered by ID", inflector.Pluralize(m.Name), m.Name)
+	f.Func().Params(
+		jen.Id("s").Op("*").Id("Store"),
+	).Id(inflector.Pluralize(m.Name)).Params().Index().Op("*").Id(m.Name).Block(
+		jen.Id("s.mu").Dot("RLock").Call(),
+		jen.Defer().Id("s.mu").Dot("RUnlock").Call(),
+		jen.Var().Id("ids").Index().String(),
+		jen.For(jen.List(jen.Id("id"), jen.Id("entry")).Op(":=").Range().Id("s.index.entries")).Block(
+			jen.If(jen.Id("entry.Sys.ContentType.Sys.ID").Op("==").Lit(m.Sys.ID)).Block(
+				jen.Id("ids").Op("=").Append(jen.Id("ids"), jen.Id("id")),
+			),
+		),
+		jen.Qual("sort", "Strings").Call(jen.Id("ids")),
+		jen.Id("cache").Op(":=").Id("newIteratorCache").Call(),
+		jen.Id("items").Op(":=").Make(jen.Index().Op("*").Id(m.Name), jen.Lit(0), jen.Len(jen.Id("ids"))),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("ids")).Block(
+			jen.If(
+				jen.List(jen.Id("item"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(jen.Id("id"), jen.Id("s.index"), jen.Id("cache")),
+				jen.Id("ok"),
+			).Block(
+				jen.Id("items").Op("=").Append(jen.Id("items"), jen.Op("&").Id("item")),
+			),
+		),
+		jen.Return(jen.Id("items")),
+	)
+
+	for _, field := range m.Fields {
+		if field.Type != "Symbol" || !field.Unique() {
+			continue
+		}
+		fieldName := fieldName(field)
+		f.Commentf("%sBy%s returns the single %s of the store with the given %s. A *DuplicateError is returned if several entries share the value", m.Name, fieldName, m.Name, field.Name)
+		f.Func().Params(
+			jen.Id("s").Op("*").Id("Store"),
+		).Id(fmt.Sprintf("%sBy%s", m.Name, fieldName)).Params(
+			jen.Id("v").String(),
+		).Params(
+			jen.Op("*").Id(m.Name), jen.Error(),
+		).Block(
+			jen.Id("s.mu").Dot("RLock").Call(),
+			jen.Defer().Id("s.mu").Dot("RUnlock").Call(),
+			jen.Id("ids").Op(":=").Id("s.unique").Index(jen.Lit(fmt.Sprintf("%s.%s", m.Sys.ID, field.Name))).Index(jen.Id("v")),
+			jen.If(jen.Len(jen.Id("ids")).Op("==").Lit(0)).Block(
+				jen.Return(jen.Nil(), jen.Op("&").Id("NotFoundError").Values(jen.Dict{
+					jen.Id("ID"):          jen.Id("v"),
+					jen.Id("Field"):       jen.Lit(field.Name),
+					jen.Id("ContentType"): jen.Lit(m.Sys.ID),
+				})),
+			),
+			jen.If(jen.Len(jen.Id("ids")).Op(">").Lit(1)).Block(
+				jen.Return(jen.Nil(), jen.Op("&").Id("DuplicateError").Values(jen.Dict{
+					jen.Id("Type"):  jen.Lit(m.Name),
+					jen.Id("Field"): jen.Lit(fieldName),
+					jen.Id("Value"): jen.Id("v"),
+				})),
+			),
+			jen.Return(jen.Id("s").Dot(fmt.Sprintf("find%s", m.Name)).Call(jen.Id("ids").Index(jen.Lit(0)))),
+		)
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+	"github.com/gedex/inflector"
+)
+
+func generateSync(f *jen.File) {
+	f.Comment("SyncResult holds the changes of a space since the previous synchronization")
+	f.Type().Id("SyncResult").StructFunc(func(g *jen.Group) {
+		for _, m := range models {
+			g.Id(inflector.Pluralize(m.Name)).Index().Op("*").Id(m.Name)
+		}
+		g.Id("Assets").Index().Op("*").Id("Asset")
+		g.Id("DeletedEntries").Index().String()
+		g.Id("DeletedAssets").Index().String()
+		g.Comment("Token is passed to the next Sync call to only receive later changes")
+		g.Id("Token").String()
+	})
+
+	f.Comment("syncItem holds a single multi locale entry, asset or deletion of a sync response")
+	f.Type().Id("syncItem").Struct(
+		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
+		jen.Id("Fields").Qual("encoding/json", "RawMessage").Tag(map[string]string{"json": "fields"}),
+	)
+
+	f.Comment("syncResponse holds a single page of a sync response")
+	f.Type().Id("syncResponse").Struct(
+		jen.Id("Items").Index().Id("syncItem").Tag(map[string]string{"json": "items"}),
+		jen.Id("NextPageURL").String().Tag(map[string]string{"json": "nextPageUrl"}),
+		jen.Id("NextSyncURL").String().Tag(map[string]string{"json": "nextSyncUrl"}),
+	)
+
+	f.Comment("syncToken extracts the sync token of a next page or next sync url")
+	f.Func().Id("syncToken").Params(
+		jen.Id("next").String(),
+	).Params(
+		jen.String(), jen.Error(),
+	).Block(
+		jen.List(jen.Id("u"), jen.Err()).Op(":=").Qual("net/url", "Parse").Call(jen.Id("next")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Lit(""), jen.Err()),
+		),
+		jen.Return(jen.Id("u").Dot("Query").Call().Dot("Get").Call(jen.Lit("sync_token")), jen.Nil()),
+	)
+
+	f.Comment("requestSync requests a single page of the sync api")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("requestSync").Params(
+		jen.Id("params").String(),
+	).Params(
+		jen.Op("*").Id("syncResponse"), jen.Error(),
+	).Block(
+		jen.Var().Id("url").Op("=").Qual("fmt", "Sprintf").Params(
+			jen.Lit("%s/spaces/%s/sync?access_token=%s&%s"),
+			jen.Id("c.host"),
+			jen.Id("c.spaceID"),
+			jen.Id("c.authToken"),
+			jen.Id("params"),
+		),
+		jen.List(jen.Id("resp"), jen.Err()).Op(":=").Id("c.get").Call(jen.Id("url")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.If(jen.Id("resp").Dot("StatusCode").Op("!=").Qual("net/http", "StatusOK")).Block(
+			jen.Return(jen.Nil(), jen.Id("responseError").Call(jen.Id("resp"))),
+		),
+		jen.Var().Id("data").Id("syncResponse"),
+		jen.Comment("sync pages hold all locales, they are localized by the caller"),
+		jen.If(
+			jen.Err().Op(":=").Id("decodeResponse").Call(jen.Id("resp"), jen.Nil(), jen.Op("&").Id("data")),
+			jen.Err().Op("!=").Nil(),
+		).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Return(jen.Op("&").Id("data"), jen.Nil()),
+	)
+
+	f.Comment("syncPages requests all pages of a synchronization, returning the entries and assets in the client locale, the deletions and the next sync token. The locales of the space are retrieved first if unknown")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("syncPages").Params(
+		jen.Id("token").String(),
+	).Params(
+		jen.Id("entries").Index().Id("includeEntry"),
+		jen.Id("assets").Index().Id("includeAsset"),
+		jen.Id("result").Op("*").Id("SyncResult"),
+		jen.Err().Error(),
+	).Block(
+		jen.Id("result").Op("=").Op("&").Id("SyncResult").Values(),
+		jen.Id("params").Op(":=").Lit("initial=true"),
+		jen.If(jen.Id("token").Op("!=").Lit("")).Block(
+			jen.Id("params").Op("=").Lit("sync_token=").Op("+").Qual("net/url", "QueryEscape").Call(jen.Id("token")),
+		),
+		jen.Comment("sync responses hold all locales and fields which are not localized only in the default locale"),
+		jen.Id("c.localesMu").Dot("RLock").Call(),
+		jen.Id("known").Op(":=").Id("c.defaultLocale").Op("!=").Lit(""),
+		jen.Id("c.localesMu").Dot("RUnlock").Call(),
+		jen.If(jen.Op("!").Id("known")).Block(
+			jen.If(
+				jen.List(jen.Id("_"), jen.Err()).Op(":=").Id("c").Dot("Locales").Call(),
+				jen.Err().Op("!=").Nil(),
+			).Block(
+				jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+			),
+		),
+		jen.Id("chain").Op(":=").Id("c").Dot("fallbackChain").Call(jen.Id("c.Locale")),
+		jen.For().Block(
+			jen.List(jen.Id("data"), jen.Err()).Op(":=").Id("c.requestSync").Call(jen.Id("params")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+			),
+			jen.For(jen.List(jen.Id("_"), jen.Id("item")).Op(":=").Range().Id("data.Items")).Block(
+				jen.Switch(jen.Id("item.Sys.Type")).Block(
+					jen.Case(jen.Lit("Entry")).Block(
+						jen.List(jen.Id("fields"), jen.Err()).Op(":=").Id("localizedFields").Call(jen.Id("item.Fields"), jen.Id("chain")),
+						jen.If(jen.Err().Op("!=").Nil()).Block(
+							jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+						),
+						jen.Id("entries").Op("=").Append(jen.Id("entries"), jen.Id("includeEntry").Values(jen.Dict{
+							jen.Id("Sys"):    jen.Id("item.Sys"),
+							jen.Id("Fields"): jen.Id("fields"),
+						})),
+					),
+					jen.Case(jen.Lit("Asset")).Block(
+						jen.List(jen.Id("fields"), jen.Err()).Op(":=").Id("localizedFields").Call(jen.Id("item.Fields"), jen.Id("chain")),
+						jen.If(jen.Err().Op("!=").Nil()).Block(
+							jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+						),
+						jen.Id("asset").Op(":=").Id("includeAsset").Values(jen.Dict{
+							jen.Id("Sys"): jen.Id("item.Sys"),
+						}),
+						jen.If(
+							jen.Err().Op(":=").Qual("encoding/json", "Unmarshal").Call(jen.Op("*").Id("fields"), jen.Op("&").Id("asset.Fields")),
+							jen.Err().Op("!=").Nil(),
+						).Block(
+							jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+						),
+						jen.Id("assets").Op("=").Append(jen.Id("assets"), jen.Id("asset")),
+					),
+					jen.Case(jen.Lit("DeletedEntry")).Block(
+						jen.Id("result.DeletedEntries").Op("=").Append(jen.Id("result.DeletedEntries"), jen.Id("item.Sys.ID")),
+					),
+					jen.Case(jen.Lit("DeletedAsset")).Block(
+						jen.Id("result.DeletedAssets").Op("=").Append(jen.Id("result.DeletedAssets"), jen.Id("item.Sys.ID")),
+					),
+				),
+			),
+			jen.If(jen.Id("data.NextPageURL").Op("==").Lit("")).Block(
+				jen.List(jen.Id("result.Token"), jen.Err()).Op("=").Id("syncToken").Call(jen.Id("data.NextSyncURL")),
+				jen.Return(jen.Id("entries"), jen.Id("assets"), jen.Id("result"), jen.Err()),
+			),
+			jen.List(jen.Id("next"), jen.Err()).Op(":=").Id("syncToken").Call(jen.Id("data.NextPageURL")),
+			jen.If(jen.Err().Op("!=").Nil()).Block(
+				jen.Return(jen.Nil(), jen.Nil(), jen.Nil(), jen.Err()),
+			),
+			jen.Id("params").Op("=").Lit("sync_token=").Op("+").Qual("net/url", "QueryEscape").Call(jen.Id("next")),
+		),
+	)
+
+	f.Comment("Sync returns all changes since the sync which returned token, or the entire space for an empty token. Changed entries are evicted from the client cache")
+	f.Func().Params(
+		jen.Id("c").Op("*").Id("ContentClient"),
+	).Id("Sync").Params(
+		jen.Id("token").String(),
+	).Params(
+		jen.Op("*").Id("SyncResult"), jen.Error(),
+	).Block(
+		jen.List(jen.Id("entries"), jen.Id("assets"), jen.Id("result"), jen.Err()).Op(":=").Id("c.syncPages").Call(jen.Id("token")),
+		jen.If(jen.Err().Op("!=").Nil()).Block(
+			jen.Return(jen.Nil(), jen.Err()),
+		),
+		jen.Comment("links are resolved within the changes, the client cache may hold outdated versions"),
+		jen.Id("index").Op(":=").Id("newLinkIndex").Call(jen.Id("entries"), jen.Id("includes").Values(jen.Dict{
+			jen.Id("Assets"): jen.Id("assets"),
+		})),
+		jen.Id("cache").Op(":=").Id("newIteratorCache").Call(),
+		jen.For(jen.List(jen.Id("_"), jen.Id("entry")).Op(":=").Range().Id("entries")).Block(
+			jen.Id("c.cache").Dot("evict").Call(jen.Lit("Entry:").Op("+").Id("entry.Sys.ID")),
+			jen.Switch(jen.Id("entry.Sys.ContentType.Sys.ID")).BlockFunc(func(g *jen.Group) {
+				for _, m := range models {
+					field := fmt.Sprintf("result.%s", inflector.Pluralize(m.Name))
+					g.Case(jen.Lit(m.Sys.ID)).Block(
+						jen.If(
+							jen.List(jen.Id("v"), jen.Id("ok")).Op(":=").Id(fmt.Sprintf("resolve%s", m.CapitalizedName())).Call(jen.Id("entry.Sys.ID"), jen.Id("index"), jen.Id("cache")),
+							jen.Id("ok"),
+						).Block(
+							jen.Id(field).Op("=").Append(jen.Id(field), jen.Op("&").Id("v")),
+						),
+					)
+				}
+			}),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("raw")).Op(":=").Range().Id("assets")).Block(
+			jen.Id("c.cache").Dot("evict").Call(jen.Lit("Asset:").Op("+").Id("raw.Sys.ID")),
+			jen.Id("asset").Op(":=").Id("toAsset").Call(jen.Id("raw")),
+			jen.Id("result.Assets").Op("=").Append(jen.Id("result.Assets"), jen.Op("&").Id("asset")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("result.DeletedEntries")).Block(
+			jen.Id("c.cache").Dot("evict").Call(jen.Lit("Entry:").Op("+").Id("id")),
+		),
+		jen.For(jen.List(jen.Id("_"), jen.Id("id")).Op(":=").Range().Id("result.DeletedAssets")).Block(
+			jen.Id("c.cache").Dot("evict").Call(jen.Lit("Asset:").Op("+").Id("id")),
+		),
+		jen.Return(jen.Id("result"), jen.Nil()),
+	)
+}
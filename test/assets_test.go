@@ -0,0 +1,59 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAssets(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		queries = append(queries, r.URL.Path[strings.LastIndex(r.URL.Path, "/assets"):]+" "+q.Get("mimetype_group")+" "+q.Get("skip")+" "+q.Get("locale"))
+		if r.URL.Path == "/spaces/ygx37epqlss8/assets/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		asset := func(id string) map[string]interface{} {
+			return map[string]interface{}{
+				"sys":    map[string]interface{}{"id": id, "type": "Asset"},
+				"fields": map[string]interface{}{"title": id, "file": map[string]interface{}{"url": "//images.example/" + id + ".png", "contentType": "image/png"}},
+			}
+		}
+		if strings.HasSuffix(r.URL.Path, "/assets") {
+			json.NewEncoder(w).Encode(map[string]interface{}{"total": 3, "items": []interface{}{asset("a" + q.Get("skip"))}})
+			return
+		}
+		json.NewEncoder(w).Encode(asset("a1"))
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.SetRateLimit(0)
+
+	assets, err := c.Assets(AssetListOptions{Limit: 1, MimetypeGroup: "image"}).Collect(0)
+	if err != nil || len(assets) != 3 || assets[2].Title != "a2" {
+		t.Fatalf("got %+v, %v", assets, err)
+	}
+	// the filter is sent escaped, so it cannot add parameters of its own
+	if _, err := c.Assets(AssetListOptions{MimetypeGroup: "image&skip=5", Locale: "de"}).Next(); err != nil {
+		t.Fatal(err)
+	}
+	a, err := c.Asset("a1")
+	if err != nil || a.Title != "a1" || a.URL != "https://images.example/a1.png" || a.ContentType != "image/png" {
+		t.Fatalf("got %+v, %v", a, err)
+	}
+	if _, err := c.Asset("a1", AssetOptions{Locale: "de"}); err != nil {
+		t.Fatal(err)
+	}
+	var notFound *NotFoundError
+	if _, err := c.Asset("missing"); !errors.As(err, &notFound) || notFound.ID != "missing" {
+		t.Fatalf("got %v, want NotFoundError", err)
+	}
+	if got := strings.Join(queries, ","); got != "/assets image 0 en-US,/assets image 1 en-US,/assets image 2 en-US,/assets image&skip=5 0 de,/assets/a1   en-US,/assets/a1   de,/assets/missing   en-US" {
+		t.Fatalf("requests %s", got)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := NewLRUCache(2, 50*time.Millisecond)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("least recently used value was not evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatal("recently used value was evicted")
+	}
+	time.Sleep(60 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("value did not expire")
+	}
+}
+
+func TestSharedCacheServesLookups(t *testing.T) {
+	s := &postServer{n: 30}
+	c := newPostServer(t, s)
+	c.SetCache(NewLRUCache(100, time.Minute))
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Posts(ListOptions{Limit: 10}).Collect(0); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	before := atomic.LoadInt32(&s.requests)
+	p, err := c.Post("p3")
+	if err != nil || p.Title != "t3" {
+		t.Fatal(p, err)
+	}
+	if atomic.LoadInt32(&s.requests) != before {
+		t.Fatal("Post was not served from the cache")
+	}
+}
+
+// stringCache stands in for an external store which keeps values as strings
+type stringCache struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+func (c *stringCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	v, ok := c.values[key]
+	return v, ok
+}
+
+func (c *stringCache) Set(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[key] = string(value.([]byte))
+}
+
+func (c *stringCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, key)
+}
+
+func TestExternalCacheServesLookups(t *testing.T) {
+	store := &stringCache{values: map[string]string{}}
+	cachedClient(t, store)
+	// a second client sharing the store resolves the post and its links without requests
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL)
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.SetCache(store)
+	p, err := c.Post("p0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Approver.Resolved || p.Approver.Value.Name != "a1" || !p.Approver.Value.ProfilePhoto.Resolved {
+		t.Fatalf("links not resolved from the cache: %+v", p.Approver)
+	}
+}
+
+func TestDroppedValuesEvictLinkingEntries(t *testing.T) {
+	c := cachedClient(t, NewLRUCache(3, 0))
+	// the asset is the least recently used value, the entries resolving through it must go along
+	c.cache.setAsset(&includeAsset{Sys: sys{ID: "other"}})
+	for _, key := range []string{"Asset:img", "Entry:a1", "Entry:p0"} {
+		if _, ok := c.cache.get(key); ok {
+			t.Fatalf("%s still cached", key)
+		}
+	}
+	if _, ok := c.cache.get("Asset:other"); !ok {
+		t.Fatal("new value not cached")
+	}
+}
+
+// racingCache runs before ahead of every Set, standing in for an invalidation arriving while a value is stored
+type racingCache struct {
+	Cache
+	before func(key string)
+}
+
+func (c *racingCache) Set(key string, value interface{}) {
+	c.before(key)
+	c.Cache.Set(key, value)
+}
+
+func TestEvictionWhileStoringDropsValue(t *testing.T) {
+	c := NewCDA("token", "en-US")
+	cache := &racingCache{Cache: NewLRUCache(0, 0)}
+	c.SetCache(cache)
+	cache.before = func(key string) { c.cache.evict(key) }
+	c.cache.setEntry(ptr(rawEntry("p0", postContentType, `{"title":"t"}`)))
+	c.cache.setAsset(&includeAsset{Sys: sys{ID: "img"}})
+	for _, key := range []string{"Entry:p0", "Asset:img"} {
+		if _, ok := c.cache.get(key); ok {
+			t.Fatalf("%s stayed cached after its eviction", key)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContentTypes(t *testing.T) {
+	var calls []string
+	stored := `{"name":"Post","displayField":"title","fields":[{"id":"title","name":"Title","type":"Symbol","localized":true,"required":true,"disabled":false,"omitted":false,"validations":[{"unique":true},{"size":{"max":80}}]},{"id":"author","name":"Author","type":"Array","localized":false,"required":false,"disabled":false,"omitted":false,"items":{"type":"Link","linkType":"Entry","validations":[{"linkContentType":["author"]}]}}]}`
+	version := 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.RequestURI()+" v="+r.Header.Get("X-Contentful-Version"))
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == "DELETE" && r.URL.Path == "/spaces/space/content_types/post" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == "PUT" && !strings.HasSuffix(r.URL.Path, "/published") {
+			stored = string(body)
+		}
+		var item map[string]interface{}
+		json.Unmarshal([]byte(stored), &item)
+		delete(item, "sys")
+		item["sys"] = map[string]interface{}{"id": "post", "version": version}
+		version++
+		if r.URL.Path == "/spaces/space/content_types" && r.Method == "GET" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"total": 1, "items": []interface{}{item}})
+			return
+		}
+		json.NewEncoder(w).Encode(item)
+	}))
+	defer srv.Close()
+	s := newTestManagement(t, srv).ContentTypes()
+
+	cts, err := s.List(ListOptions{}).Collect(0)
+	if err != nil || len(cts) != 1 || cts[0].ID != "post" {
+		t.Fatal(cts, err)
+	}
+	ct, err := s.UpdateWithRetry("post", func(ct *ContentType) error {
+		ct.Fields = append(ct.Fields, Field{ID: "slug", Name: "Slug", Type: "Symbol", Validations: []Validation{{Unique: true}}})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ct.Fields) != 3 || !ct.Fields[0].Validations[0].Unique || string(ct.Fields[0].Validations[1].Other["size"]) != `{"max":80}` || ct.Fields[1].Items.Validations[0].LinkContentType[0] != "author" {
+		t.Fatalf("content type %+v", ct)
+	}
+	// unknown validations survive the round trip and sys is not sent back
+	if !strings.Contains(stored, `{"size":{"max":80}}`) || strings.Contains(stored, `"sys"`) {
+		t.Fatalf("stored %s", stored)
+	}
+	if err := s.Publish(ct); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Unpublish(ct); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete("post"); err != nil {
+		t.Fatal(err)
+	}
+	want := "GET /spaces/space/content_types?limit=100&skip=0&order=sys.createdAt,sys.id v=|GET /spaces/space/content_types/post v=|PUT /spaces/space/content_types/post v=2|PUT /spaces/space/content_types/post/published v=3|DELETE /spaces/space/content_types/post/published v=4|DELETE /spaces/space/content_types/post v="
+	if got := strings.Join(calls, "|"); got != want {
+		t.Fatalf("got %s", got)
+	}
+}
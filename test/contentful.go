@@ -1,14 +1,28 @@
 package main
 
 import (
-	bytes "bytes"
-	tls "crypto/tls"
-	x509 "crypto/x509"
-	json "encoding/json"
-	fmt "fmt"
-	http "net/http"
-	strings "strings"
-	time "time"
+	"bytes"
+	"container/list"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 )
 
 const dateLayout = "2006-01-02"
@@ -27,14 +41,22 @@ func (d *Date) UnmarshalJSON(b []byte) error {
 	return err
 }
 
+// MarshalJSON serializes a date as iso 8601 short date string
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Time(d).Format(dateLayout))
+}
+
 // Asset defines a media item in contentful
 type Asset struct {
+	ID          string
 	Title       string
 	Description string
 	URL         string
 	Width       int64
 	Height      int64
 	Size        int64
+	FileName    string
+	ContentType string
 }
 type includes struct {
 	Entries []includeEntry `json:"Entry"`
@@ -50,6 +72,40 @@ type sys struct {
 		} `json:"sys"`
 	} `json:"contentType"`
 }
+
+// Ref links to an entry or asset. Resolved is false when the target was not part of the response, e.g. because it is unpublished, deleted or beyond the include depth
+type Ref[T any] struct {
+	ID       string
+	Resolved bool
+	Value    T
+}
+
+// IncludeError describes a link contentful reported in the errors of a response, e.g. as notResolvable
+type IncludeError struct {
+	Sys struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"sys"`
+	Details struct {
+		Type     string `json:"type"`
+		LinkType string `json:"linkType"`
+		ID       string `json:"id"`
+	} `json:"details"`
+}
+
+func (e IncludeError) Error() string {
+	return fmt.Sprintf("%s: %s %s", e.Sys.ID, e.Details.LinkType, e.Details.ID)
+}
+
+// entriesResponse holds an entire contentful response of mixed content types
+type entriesResponse struct {
+	Total    int            `json:"total"`
+	Skip     int            `json:"skip"`
+	Limit    int            `json:"limit"`
+	Items    []includeEntry `json:"items"`
+	Includes includes       `json:"includes"`
+	Errors   []IncludeError `json:"errors"`
+}
 type entryID struct {
 	Sys sys `json:"sys"`
 }
@@ -61,9 +117,14 @@ type includeEntry struct {
 type includeAsset struct {
 	Sys    sys `json:"sys"`
 	Fields struct {
-		File struct {
-			URL     string `json:"url"`
-			Details struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+		File        struct {
+			URL         string `json:"url"`
+			FileName    string `json:"fileName"`
+			ContentType string `json:"contentType"`
+			Details     struct {
+				Size  int64 `json:"size"`
 				Image struct {
 					Width  int64 `json:"width"`
 					Height int64 `json:"height"`
@@ -72,26 +133,137 @@ type includeAsset struct {
 		} `json:"file"`
 	} `json:"fields"`
 }
+
+// Entry is implemented by every generated content type. It is used for links without content type restrictions
+type Entry interface {
+	isEntry()
+}
+
+func (Post) isEntry()     {}
+func (Author) isEntry()   {}
+func (Category) isEntry() {}
+
+// refAs narrows an entry link to the types allowed by a field. Entries of other types are reported as unresolved
+func refAs[T any](ref Ref[Entry]) Ref[T] {
+	v, ok := ref.Value.(T)
+	return Ref[T]{
+		ID:       ref.ID,
+		Resolved: ref.Resolved && ok,
+		Value:    v,
+	}
+}
+func refsAs[T any](refs []Ref[Entry]) []Ref[T] {
+	var items []Ref[T]
+	for _, ref := range refs {
+		items = append(items, refAs[T](ref))
+	}
+	return items
+}
+
 type iteratorCache struct {
 	posts     map[string]*Post
 	authors   map[string]*Author
 	categorys map[string]*Category
+	fetched   *linkIndex
+	locale    string
+	shared    *sharedCache
+}
+
+func newIteratorCache() *iteratorCache {
+	return &iteratorCache{
+		authors:   make(map[string]*Author),
+		categorys: make(map[string]*Category),
+		fetched:   newLinkIndex(nil, includes{}),
+		posts:     make(map[string]*Post),
+	}
+}
+
+// has reports whether an entry of any content type has already been resolved
+func (c *iteratorCache) has(id string) bool {
+	if _, ok := c.posts[id]; ok {
+		return true
+	}
+	if _, ok := c.authors[id]; ok {
+		return true
+	}
+	if _, ok := c.categorys[id]; ok {
+		return true
+	}
+	_, ok := c.shared.get("Entry:" + id)
+	return ok
+}
+
+// defaultOrder keeps pagination stable when entries are added or removed mid iteration
+const defaultOrder = "sys.createdAt,sys.id"
+
+// queryBuilder collects search parameters shared by all generated query builders
+type queryBuilder struct {
+	values url.Values
+	order  []string
+	fields []string
+}
+
+func (q *queryBuilder) set(key string, value string) {
+	if q.values == nil {
+		q.values = url.Values{}
+	}
+	q.values.Set(key, value)
+}
+func (q *queryBuilder) encode() string {
+	values := url.Values{}
+	for k, v := range q.values {
+		values[k] = v
+	}
+	order := defaultOrder
+	if len(q.order) > 0 {
+		order = strings.Join(q.order, ",")
+		// sys.id breaks ties so pages don't overlap when sorting by non-unique fields
+		if !strings.Contains(order, "sys.id") {
+			order += ",sys.id"
+		}
+	}
+	values.Set("order", order)
+	if len(q.fields) > 0 {
+		values.Set("select", strings.Join(append([]string{"sys"}, q.fields...), ","))
+	}
+	return values.Encode()
 }
 
 // PostIterator is used to paginate result sets of Post
 type PostIterator struct {
-	Page         int
 	Limit        int
 	Offset       int
+	total        int
+	fetched      bool
 	IncludeCount int
 	c            *ContentClient
 	items        []*Post
 	lookupCache  *iteratorCache
+	locale       string
+	query        string
+	pageLimit    int
+	prefetch     *prefetcher[*postResponse]
+	errors       []IncludeError
+}
+
+// setPage records a fetched page in the pagination state
+func (it *PostIterator) setPage(items []*Post, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
 }
 
 // Next returns the following item of type Post. If none exists a network request will be executed
 func (it *PostIterator) Next() (*Post, error) {
 	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
 		if err := it.fetch(); err != nil {
 			return nil, err
 		}
@@ -100,52 +272,231 @@ func (it *PostIterator) Next() (*Post, error) {
 		return nil, ErrIteratorDone
 	}
 	var item *Post
-	item, it.items = it.items[len(it.items)-1], it.items[:len(it.items)-1]
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of Post. If none exists a network request will be executed
+func (it *PostIterator) Page() ([]*Post, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
 	if len(it.items) == 0 {
-		it.Page++
-		it.Offset = it.Page * it.Limit
+		return nil, ErrIteratorDone
 	}
-	return item, nil
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining Post items. Iteration stops after the first error
+func (it *PostIterator) All() iter.Seq2[*Post, error] {
+	return func(yield func(*Post, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining Post items. A max of zero or less collects all items
+func (it *PostIterator) Collect(max int) ([]*Post, error) {
+	var items []*Post
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining Post item and stops at the first error
+func (it *PostIterator) ForEach(fn func(*Post) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *PostIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type Post
+func (it *PostIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+
+// Errors returns the links contentful reported as unresolvable in the pages fetched so far
+func (it *PostIterator) Errors() []IncludeError {
+	return it.errors
+}
+func (it *PostIterator) params(offset, limit int) string {
+	params := fmt.Sprintf("include=%d&limit=%d&skip=%d", it.IncludeCount, limit, offset)
+	if it.query != "" {
+		params += "&" + it.query
+	}
+	return params
 }
 func (it *PostIterator) fetch() error {
-	c := it.c
-	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&include=%d&locale=%s&limit=%d&skip=%d", c.host, c.spaceID, c.authToken, "2wKn6yEnZewu2SCCkus4as", it.IncludeCount, c.Locale, it.Limit, it.Offset)
-	resp, err := c.client.Get(url)
+	if it.pageLimit <= 0 || it.pageLimit > it.Limit {
+		it.pageLimit = it.Limit
+	}
+	data, ok, err := it.prefetch.take()
+	if !ok {
+		data, err = it.c.requestPosts(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	// contentful rejects large responses, retry with smaller pages
+	for errors.Is(err, ErrResponseTooBig) && it.pageLimit > 1 {
+		it.pageLimit /= 2
+		data, err = it.c.requestPosts(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	if err != nil {
+		return err
+	}
+	items, err := it.c.decodePosts(data, it.lookupCache)
 	if err != nil {
 		return err
 	}
+	it.errors = append(it.errors, data.Errors...)
+	it.setPage(items, data.Total)
+	if it.pageLimit < it.Limit {
+		it.pageLimit = min(it.pageLimit*2, it.Limit)
+	}
+	limit := it.pageLimit
+	it.prefetch.fill(it.Offset, limit, it.total, func(offset int) (*postResponse, error) {
+		return it.c.requestPosts(it.locale, it.params(offset, limit))
+	})
+	return nil
+}
+
+// fetchPosts requests and resolves Post entries matching the given query parameters
+func (c *ContentClient) fetchPosts(params string, cache *iteratorCache) ([]*Post, int, error) {
+	data, err := c.requestPosts(cache.locale, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	items, err := c.decodePosts(data, cache)
+	return items, data.Total, err
+}
+
+// requestPosts requests a raw page of Post entries matching the given query parameters
+func (c *ContentClient) requestPosts(locale string, params string) (*postResponse, error) {
+	chain := c.fallbackChain(locale)
+	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&locale=%s&%s", c.host, c.spaceID, c.authToken, "2wKn6yEnZewu2SCCkus4as", localeQuery(chain), params)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
+		return nil, responseError(resp)
 	}
 	var data postResponse
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return err
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
 	}
-	if err := resp.Body.Close(); err != nil {
-		return err
+	return &data, nil
+}
+
+// decodePosts resolves the Post entries of a raw response
+func (c *ContentClient) decodePosts(data *postResponse, cache *iteratorCache) ([]*Post, error) {
+	index := newLinkIndex(data.Items, data.Includes)
+	if c.fetchLinks {
+		if err := c.completeLinks(index, cache); err != nil {
+			return nil, err
+		}
 	}
 	var items = make([]*Post, len(data.Items))
 	for i, raw := range data.Items {
 		var item postItem
 		if err := json.Unmarshal(*raw.Fields, &item.Fields); err != nil {
-			return err
+			return nil, err
 		}
-		items[i] = &Post{
-			Approver:      resolveAuthor(item.Fields.Approver.Sys.ID, data.Items, data.Includes, it.lookupCache),
-			Author:        resolveAuthors(item.Fields.Author, data.Items, data.Includes, it.lookupCache),
-			AuthorOrPost:  resolveEntries(item.Fields.AuthorOrPost, data.Items, data.Includes, it.lookupCache),
+		entry := Post{
+			Approver:      resolveAuthorRef(item.Fields.Approver.Sys.ID, index, cache),
+			Author:        resolveAuthors(item.Fields.Author, index, cache),
+			AuthorOrPost:  refsAs[PostAuthorOrPostItem](resolveEntries(item.Fields.AuthorOrPost, index, cache)),
 			Body:          item.Fields.Body,
-			Category:      resolveCategorys(item.Fields.Category, data.Items, data.Includes, it.lookupCache),
+			Category:      resolveCategorys(item.Fields.Category, index, cache),
 			Comments:      item.Fields.Comments,
 			Date:          item.Fields.Date,
-			FeaturedImage: resolveAsset(item.Fields.FeaturedImage.Sys.ID, data.Includes),
+			FeaturedImage: resolveAsset(item.Fields.FeaturedImage.Sys.ID, index, cache),
 			ID:            raw.Sys.ID,
 			Slug:          item.Fields.Slug,
+			Tags:          item.Fields.Tags,
 			Title:         item.Fields.Title,
 		}
+		cache.shared.setEntry(&raw)
+		items[i] = &entry
 	}
-	it.items = items
-	return nil
+	return items, nil
+}
+
+// Post retrieves a single Post entry by its ID
+func (c *ContentClient) Post(id string) (*Post, error) {
+	// entries in the client cache resolve without a request
+	if entry, ok := resolvePost(id, newLinkIndex(nil, includes{}), c.newCache()); ok {
+		return &entry, nil
+	}
+	items, _, err := c.fetchPosts(fmt.Sprintf("include=%d&limit=1&sys.id=%s", maxIncludeDepth, url.QueryEscape(id)), c.newCache())
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, &NotFoundError{
+			ContentType: "2wKn6yEnZewu2SCCkus4as",
+			ID:          id,
+		}
+	}
+	return items[0], nil
+}
+
+// PostBySlug retrieves the single Post entry with the given slug. A *DuplicateError is returned if several entries share the value
+func (c *ContentClient) PostBySlug(v string) (*Post, error) {
+	items, _, err := c.fetchPosts(fmt.Sprintf("include=%d&limit=2&fields.slug=%s", maxIncludeDepth, url.QueryEscape(v)), c.newCache())
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, &NotFoundError{
+			ContentType: "2wKn6yEnZewu2SCCkus4as",
+			Field:       "slug",
+			ID:          v,
+		}
+	}
+	if len(items) > 1 {
+		return nil, &DuplicateError{
+			Field: "Slug",
+			Type:  "Post",
+			Value: v,
+		}
+	}
+	return items[0], nil
 }
 
 // Post has no description in contentful
@@ -153,15 +504,15 @@ type Post struct {
 	ID            string
 	Title         string
 	Slug          string
-	Author        []Author
+	Author        []Ref[Author]
 	Body          string
-	Category      []Category
+	Category      []Ref[Category]
 	Tags          []string
-	FeaturedImage Asset
+	FeaturedImage Ref[Asset]
 	Date          Date
 	Comments      bool
-	Approver      Author
-	AuthorOrPost  []interface{}
+	Approver      Ref[Author]
+	AuthorOrPost  []Ref[PostAuthorOrPostItem]
 }
 
 // postItem contains a single contentful Post model
@@ -189,88 +540,70 @@ type postResponse struct {
 	Limit    int            `json:"limit"`
 	Items    []includeEntry `json:"items"`
 	Includes includes       `json:"includes"`
+	Errors   []IncludeError `json:"errors"`
 }
 
-func resolvePost(entryID string, items []includeEntry, includes includes, cache *iteratorCache) Post {
+func resolvePost(entryID string, index *linkIndex, cache *iteratorCache) (Post, bool) {
 	if v, ok := cache.posts[entryID]; ok {
-		return *v
+		return *v, true
+	}
+	entry, cached := cache.shared.entry(entryID)
+	if !cached {
+		entry = index.entries[entryID]
+	}
+	if entry == nil || entry.Sys.ContentType.Sys.ID != "2wKn6yEnZewu2SCCkus4as" {
+		return Post{}, false
 	}
 	var item postItem
-	for _, entry := range append(includes.Entries, items...) {
-		if entry.Sys.ID == entryID {
-			if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-				return Post{}
-			}
-			var tmp = &Post{
-				Body:     item.Fields.Body,
-				Comments: item.Fields.Comments,
-				Date:     item.Fields.Date,
-				ID:       entry.Sys.ID,
-				Slug:     item.Fields.Slug,
-				Title:    item.Fields.Title,
-			}
-			cache.posts[entry.Sys.ID] = tmp
-			tmp.Approver = resolveAuthor(item.Fields.Approver.Sys.ID, items, includes, cache)
-			tmp.AuthorOrPost = resolveEntries(item.Fields.AuthorOrPost, items, includes, cache)
-			tmp.Author = resolveAuthors(item.Fields.Author, items, includes, cache)
-			tmp.Category = resolveCategorys(item.Fields.Category, items, includes, cache)
-			tmp.FeaturedImage = resolveAsset(item.Fields.FeaturedImage.Sys.ID, includes)
-			return *tmp
-		}
-	}
-	return Post{}
-}
-func resolvePostPtr(entryID string, items []includeEntry, includes includes, cache *iteratorCache) *Post {
-	var item = resolvePost(entryID, items, includes, cache)
-	return &item
-}
-func resolvePostsPtr(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []*Post {
-	var items = resolvePosts(ids, its, includes, cache)
-	var ptrs []*Post
-	for i := range items {
-		ptrs = append(ptrs, &items[i])
+	if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+		return Post{}, false
+	}
+	var tmp = &Post{
+		Body:     item.Fields.Body,
+		Comments: item.Fields.Comments,
+		Date:     item.Fields.Date,
+		ID:       entry.Sys.ID,
+		Slug:     item.Fields.Slug,
+		Title:    item.Fields.Title,
+	}
+	cache.posts[entry.Sys.ID] = tmp
+	tmp.Approver = resolveAuthorRef(item.Fields.Approver.Sys.ID, index, cache)
+	tmp.Author = resolveAuthors(item.Fields.Author, index, cache)
+	tmp.AuthorOrPost = refsAs[PostAuthorOrPostItem](resolveEntries(item.Fields.AuthorOrPost, index, cache))
+	tmp.Category = resolveCategorys(item.Fields.Category, index, cache)
+	tmp.FeaturedImage = resolveAsset(item.Fields.FeaturedImage.Sys.ID, index, cache)
+	tmp.Tags = item.Fields.Tags
+	if !cached {
+		cache.shared.setEntry(entry)
 	}
-	return ptrs
+	return *tmp, true
 }
-func resolvePosts(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []Post {
-	var items []Post
-	entries := append(includes.Entries, its...)
+func resolvePostRef(entryID string, index *linkIndex, cache *iteratorCache) Ref[Post] {
+	ref := Ref[Post]{ID: entryID}
+	ref.Value, ref.Resolved = resolvePost(entryID, index, cache)
+	return ref
+}
+func resolvePostPtr(entryID string, index *linkIndex, cache *iteratorCache) Ref[*Post] {
+	ref := Ref[*Post]{ID: entryID}
+	if item, ok := resolvePost(entryID, index, cache); ok {
+		ref.Value = &item
+		ref.Resolved = true
+	}
+	return ref
+}
+func resolvePostsPtr(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[*Post] {
+	var refs []Ref[*Post]
 	for _, entryID := range ids {
-		var item postItem
-		var entry *includeEntry
-		for _, e := range entries {
-			if e.Sys.ID == entryID.Sys.ID {
-				entry = &e
-				break
-			}
-		}
-		if entry == nil {
-			continue
-		}
-		if v, ok := cache.posts[entry.Sys.ID]; ok {
-			items = append(items, *v)
-			continue
-		}
-		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-			return items
-		}
-		var tmp = &Post{
-			Body:     item.Fields.Body,
-			Comments: item.Fields.Comments,
-			Date:     item.Fields.Date,
-			ID:       entry.Sys.ID,
-			Slug:     item.Fields.Slug,
-			Title:    item.Fields.Title,
-		}
-		cache.posts[entry.Sys.ID] = tmp
-		tmp.Author = resolveAuthors(item.Fields.Author, its, includes, cache)
-		tmp.Category = resolveCategorys(item.Fields.Category, its, includes, cache)
-		tmp.FeaturedImage = resolveAsset(item.Fields.FeaturedImage.Sys.ID, includes)
-		tmp.Approver = resolveAuthor(item.Fields.Approver.Sys.ID, its, includes, cache)
-		tmp.AuthorOrPost = resolveEntries(item.Fields.AuthorOrPost, its, includes, cache)
-		items = append(items, *tmp)
+		refs = append(refs, resolvePostPtr(entryID.Sys.ID, index, cache))
 	}
-	return items
+	return refs
+}
+func resolvePosts(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[Post] {
+	var refs []Ref[Post]
+	for _, entryID := range ids {
+		refs = append(refs, resolvePostRef(entryID.Sys.ID, index, cache))
+	}
+	return refs
 }
 
 // Posts retrieves paginated Post entries
@@ -278,231 +611,482 @@ func (c *ContentClient) Posts(opts ListOptions) *PostIterator {
 	if opts.Limit <= 0 {
 		opts.Limit = 100
 	}
+	if opts.Locale == "" {
+		opts.Locale = c.Locale
+	}
 	it := &PostIterator{
 		IncludeCount: opts.IncludeCount,
 		Limit:        opts.Limit,
-		Page:         opts.Page,
+		Offset:       opts.Page * opts.Limit,
 		c:            c,
-		lookupCache: &iteratorCache{
-			authors:   make(map[string]*Author),
-			categorys: make(map[string]*Category),
-			posts:     make(map[string]*Post),
-		},
+		locale:       opts.Locale,
+		lookupCache:  c.newLocaleCache(opts.Locale),
+		query:        PostQuery().encode(),
+	}
+	if opts.Prefetch > 0 {
+		it.prefetch = &prefetcher[*postResponse]{pages: opts.Prefetch}
 	}
 	return it
 }
 
-// AuthorIterator is used to paginate result sets of Author
-type AuthorIterator struct {
-	Page         int
-	Limit        int
-	Offset       int
-	IncludeCount int
-	c            *ContentClient
-	items        []*Author
-	lookupCache  *iteratorCache
+// PostField names a Post field which can be selected in queries
+type PostField string
+
+const (
+	PostFieldTitle         PostField = "fields.title"
+	PostFieldSlug          PostField = "fields.slug"
+	PostFieldAuthor        PostField = "fields.author"
+	PostFieldBody          PostField = "fields.body"
+	PostFieldCategory      PostField = "fields.category"
+	PostFieldTags          PostField = "fields.tags"
+	PostFieldFeaturedImage PostField = "fields.featuredImage"
+	PostFieldDate          PostField = "fields.date"
+	PostFieldComments      PostField = "fields.comments"
+	PostFieldApprover      PostField = "fields.approver"
+	PostFieldAuthorOrPost  PostField = "fields.authorOrPost"
+)
+
+// PostQueryBuilder builds filtered and ordered queries for Post entries
+type PostQueryBuilder struct {
+	queryBuilder
 }
 
-// Next returns the following item of type Author. If none exists a network request will be executed
-func (it *AuthorIterator) Next() (*Author, error) {
-	if len(it.items) == 0 {
-		if err := it.fetch(); err != nil {
-			return nil, err
-		}
-	}
-	if len(it.items) == 0 {
-		return nil, ErrIteratorDone
-	}
-	var item *Author
-	item, it.items = it.items[len(it.items)-1], it.items[:len(it.items)-1]
-	if len(it.items) == 0 {
-		it.Page++
-		it.Offset = it.Page * it.Limit
-	}
-	return item, nil
+// PostQuery returns an empty query for Post entries
+func PostQuery() *PostQueryBuilder {
+	return &PostQueryBuilder{}
 }
-func (it *AuthorIterator) fetch() error {
-	c := it.c
-	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&include=%d&locale=%s&limit=%d&skip=%d", c.host, c.spaceID, c.authToken, "1kUEViTN4EmGiEaaeC6ouY", it.IncludeCount, c.Locale, it.Limit, it.Offset)
-	resp, err := c.client.Get(url)
-	if err != nil {
-		return err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
-	}
-	var data authorResponse
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return err
-	}
-	if err := resp.Body.Close(); err != nil {
-		return err
-	}
-	var items = make([]*Author, len(data.Items))
-	for i, raw := range data.Items {
-		var item authorItem
-		if err := json.Unmarshal(*raw.Fields, &item.Fields); err != nil {
-			return err
-		}
-		items[i] = &Author{
-			Age:            item.Fields.Age,
-			Biography:      item.Fields.Biography,
-			CreatedEntries: resolvePosts(item.Fields.CreatedEntries, data.Items, data.Includes, it.lookupCache),
-			ID:             raw.Sys.ID,
-			Name:           item.Fields.Name,
-			ProfilePhoto:   resolveAsset(item.Fields.ProfilePhoto.Sys.ID, data.Includes),
-			Rating:         item.Fields.Rating,
-			Website:        item.Fields.Website,
-		}
+
+// Search filters entries by a full text search across all text fields
+func (q *PostQueryBuilder) Search(text string) *PostQueryBuilder {
+	q.set("query", text)
+	return q
+}
+
+// Select restricts the returned Post fields
+func (q *PostQueryBuilder) Select(fields ...PostField) *PostQueryBuilder {
+	for _, field := range fields {
+		q.fields = append(q.fields, string(field))
 	}
-	it.items = items
-	return nil
+	return q
 }
 
-// Author a
-type Author struct {
-	ID             string
-	Name           string
-	Website        string
-	ProfilePhoto   Asset
-	Biography      string
-	CreatedEntries []Post
-	Age            int64
-	Rating         float64
+// OrderByCreatedAtAsc sorts entries by CreatedAt in ascending order
+func (q *PostQueryBuilder) OrderByCreatedAtAsc() *PostQueryBuilder {
+	q.order = append(q.order, "sys.createdAt")
+	return q
 }
 
-// authorItem contains a single contentful Author model
-type authorItem struct {
-	Sys    sys `json:"sys"`
-	Fields struct {
-		Name           string   `json:"name"`
-		Website        string   `json:"website"`
-		ProfilePhoto   entryID  `json:"profilePhoto"`
-		Biography      string   `json:"biography"`
-		CreatedEntries entryIDs `json:"createdEntries"`
-		Age            int64    `json:"age"`
-		Rating         float64  `json:"rating"`
-	} `json:"fields"`
+// OrderByCreatedAtDesc sorts entries by CreatedAt in descending order
+func (q *PostQueryBuilder) OrderByCreatedAtDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-sys.createdAt")
+	return q
 }
 
-// authorResponse holds an entire contentful Author response
-type authorResponse struct {
-	Total    int            `json:"total"`
-	Skip     int            `json:"skip"`
-	Limit    int            `json:"limit"`
-	Items    []includeEntry `json:"items"`
-	Includes includes       `json:"includes"`
+// OrderByUpdatedAtAsc sorts entries by UpdatedAt in ascending order
+func (q *PostQueryBuilder) OrderByUpdatedAtAsc() *PostQueryBuilder {
+	q.order = append(q.order, "sys.updatedAt")
+	return q
 }
 
-func resolveAuthor(entryID string, items []includeEntry, includes includes, cache *iteratorCache) Author {
-	if v, ok := cache.authors[entryID]; ok {
-		return *v
-	}
-	var item authorItem
-	for _, entry := range append(includes.Entries, items...) {
-		if entry.Sys.ID == entryID {
-			if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-				return Author{}
-			}
-			var tmp = &Author{
-				Age:       item.Fields.Age,
-				Biography: item.Fields.Biography,
-				ID:        entry.Sys.ID,
-				Name:      item.Fields.Name,
-				Rating:    item.Fields.Rating,
-				Website:   item.Fields.Website,
-			}
-			cache.authors[entry.Sys.ID] = tmp
-			tmp.ProfilePhoto = resolveAsset(item.Fields.ProfilePhoto.Sys.ID, includes)
-			tmp.CreatedEntries = resolvePosts(item.Fields.CreatedEntries, items, includes, cache)
-			return *tmp
-		}
-	}
-	return Author{}
+// OrderByUpdatedAtDesc sorts entries by UpdatedAt in descending order
+func (q *PostQueryBuilder) OrderByUpdatedAtDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-sys.updatedAt")
+	return q
 }
-func resolveAuthorPtr(entryID string, items []includeEntry, includes includes, cache *iteratorCache) *Author {
-	var item = resolveAuthor(entryID, items, includes, cache)
-	return &item
+
+// TitleEquals filters entries by an exact value
+func (q *PostQueryBuilder) TitleEquals(v string) *PostQueryBuilder {
+	q.set("fields.title", v)
+	return q
 }
-func resolveAuthorsPtr(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []*Author {
-	var items = resolveAuthors(ids, its, includes, cache)
-	var ptrs []*Author
-	for i := range items {
-		ptrs = append(ptrs, &items[i])
-	}
-	return ptrs
+
+// TitleNotEquals excludes entries with the given value
+func (q *PostQueryBuilder) TitleNotEquals(v string) *PostQueryBuilder {
+	q.set("fields.title[ne]", v)
+	return q
 }
-func resolveAuthors(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []Author {
-	var items []Author
-	entries := append(includes.Entries, its...)
-	for _, entryID := range ids {
-		var item authorItem
-		var entry *includeEntry
-		for _, e := range entries {
-			if e.Sys.ID == entryID.Sys.ID {
-				entry = &e
-				break
-			}
-		}
-		if entry == nil {
-			continue
-		}
-		if v, ok := cache.authors[entry.Sys.ID]; ok {
-			items = append(items, *v)
-			continue
-		}
-		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-			return items
-		}
-		var tmp = &Author{
-			Age:       item.Fields.Age,
-			Biography: item.Fields.Biography,
-			ID:        entry.Sys.ID,
-			Name:      item.Fields.Name,
-			Rating:    item.Fields.Rating,
-			Website:   item.Fields.Website,
-		}
-		cache.authors[entry.Sys.ID] = tmp
-		tmp.ProfilePhoto = resolveAsset(item.Fields.ProfilePhoto.Sys.ID, includes)
-		tmp.CreatedEntries = resolvePosts(item.Fields.CreatedEntries, its, includes, cache)
-		items = append(items, *tmp)
-	}
-	return items
+
+// TitleIn filters entries matching any of the given values
+func (q *PostQueryBuilder) TitleIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.title[in]", strings.Join(vs, ","))
+	return q
 }
 
-// Authors retrieves paginated Author entries
-func (c *ContentClient) Authors(opts ListOptions) *AuthorIterator {
-	if opts.Limit <= 0 {
-		opts.Limit = 100
+// TitleNotIn excludes entries matching any of the given values
+func (q *PostQueryBuilder) TitleNotIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.title[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// TitleMatch filters entries by a full text search on this field
+func (q *PostQueryBuilder) TitleMatch(v string) *PostQueryBuilder {
+	q.set("fields.title[match]", v)
+	return q
+}
+
+// TitleExists filters entries by whether Title is set
+func (q *PostQueryBuilder) TitleExists(v bool) *PostQueryBuilder {
+	q.set("fields.title[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByTitleAsc sorts entries by Title in ascending order
+func (q *PostQueryBuilder) OrderByTitleAsc() *PostQueryBuilder {
+	q.order = append(q.order, "fields.title")
+	return q
+}
+
+// OrderByTitleDesc sorts entries by Title in descending order
+func (q *PostQueryBuilder) OrderByTitleDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-fields.title")
+	return q
+}
+
+// SlugEquals filters entries by an exact value
+func (q *PostQueryBuilder) SlugEquals(v string) *PostQueryBuilder {
+	q.set("fields.slug", v)
+	return q
+}
+
+// SlugNotEquals excludes entries with the given value
+func (q *PostQueryBuilder) SlugNotEquals(v string) *PostQueryBuilder {
+	q.set("fields.slug[ne]", v)
+	return q
+}
+
+// SlugIn filters entries matching any of the given values
+func (q *PostQueryBuilder) SlugIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.slug[in]", strings.Join(vs, ","))
+	return q
+}
+
+// SlugNotIn excludes entries matching any of the given values
+func (q *PostQueryBuilder) SlugNotIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.slug[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// SlugMatch filters entries by a full text search on this field
+func (q *PostQueryBuilder) SlugMatch(v string) *PostQueryBuilder {
+	q.set("fields.slug[match]", v)
+	return q
+}
+
+// SlugExists filters entries by whether Slug is set
+func (q *PostQueryBuilder) SlugExists(v bool) *PostQueryBuilder {
+	q.set("fields.slug[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderBySlugAsc sorts entries by Slug in ascending order
+func (q *PostQueryBuilder) OrderBySlugAsc() *PostQueryBuilder {
+	q.order = append(q.order, "fields.slug")
+	return q
+}
+
+// OrderBySlugDesc sorts entries by Slug in descending order
+func (q *PostQueryBuilder) OrderBySlugDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-fields.slug")
+	return q
+}
+
+// AuthorContains filters entries linking to the given ID
+func (q *PostQueryBuilder) AuthorContains(v string) *PostQueryBuilder {
+	q.set("fields.author.sys.id", v)
+	return q
+}
+
+// AuthorContainsAny filters entries linking to any of the given IDs
+func (q *PostQueryBuilder) AuthorContainsAny(vs ...string) *PostQueryBuilder {
+	q.set("fields.author.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// AuthorExists filters entries by whether Author is set
+func (q *PostQueryBuilder) AuthorExists(v bool) *PostQueryBuilder {
+	q.set("fields.author[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// BodyMatch filters entries by a full text search on this field
+func (q *PostQueryBuilder) BodyMatch(v string) *PostQueryBuilder {
+	q.set("fields.body[match]", v)
+	return q
+}
+
+// BodyExists filters entries by whether Body is set
+func (q *PostQueryBuilder) BodyExists(v bool) *PostQueryBuilder {
+	q.set("fields.body[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// CategoryContains filters entries linking to the given ID
+func (q *PostQueryBuilder) CategoryContains(v string) *PostQueryBuilder {
+	q.set("fields.category.sys.id", v)
+	return q
+}
+
+// CategoryContainsAny filters entries linking to any of the given IDs
+func (q *PostQueryBuilder) CategoryContainsAny(vs ...string) *PostQueryBuilder {
+	q.set("fields.category.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// CategoryExists filters entries by whether Category is set
+func (q *PostQueryBuilder) CategoryExists(v bool) *PostQueryBuilder {
+	q.set("fields.category[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// TagsContains filters entries containing the given value
+func (q *PostQueryBuilder) TagsContains(v string) *PostQueryBuilder {
+	q.set("fields.tags", v)
+	return q
+}
+
+// TagsContainsAny filters entries containing any of the given values
+func (q *PostQueryBuilder) TagsContainsAny(vs ...string) *PostQueryBuilder {
+	q.set("fields.tags[in]", strings.Join(vs, ","))
+	return q
+}
+
+// TagsContainsAll filters entries containing all of the given values
+func (q *PostQueryBuilder) TagsContainsAll(vs ...string) *PostQueryBuilder {
+	q.set("fields.tags[all]", strings.Join(vs, ","))
+	return q
+}
+
+// TagsContainsNone excludes entries containing any of the given values
+func (q *PostQueryBuilder) TagsContainsNone(vs ...string) *PostQueryBuilder {
+	q.set("fields.tags[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// TagsExists filters entries by whether Tags is set
+func (q *PostQueryBuilder) TagsExists(v bool) *PostQueryBuilder {
+	q.set("fields.tags[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// FeaturedImageIDEquals filters entries linking to the given ID
+func (q *PostQueryBuilder) FeaturedImageIDEquals(v string) *PostQueryBuilder {
+	q.set("fields.featuredImage.sys.id", v)
+	return q
+}
+
+// FeaturedImageIDIn filters entries linking to any of the given IDs
+func (q *PostQueryBuilder) FeaturedImageIDIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.featuredImage.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// FeaturedImageExists filters entries by whether FeaturedImage is set
+func (q *PostQueryBuilder) FeaturedImageExists(v bool) *PostQueryBuilder {
+	q.set("fields.featuredImage[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// DateEquals filters entries by an exact value
+func (q *PostQueryBuilder) DateEquals(v time.Time) *PostQueryBuilder {
+	q.set("fields.date", v.Format(time.RFC3339))
+	return q
+}
+
+// DateNotEquals excludes entries with the given value
+func (q *PostQueryBuilder) DateNotEquals(v time.Time) *PostQueryBuilder {
+	q.set("fields.date[ne]", v.Format(time.RFC3339))
+	return q
+}
+
+// DateIn filters entries matching any of the given values
+func (q *PostQueryBuilder) DateIn(vs ...time.Time) *PostQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = v.Format(time.RFC3339)
 	}
-	it := &AuthorIterator{
-		IncludeCount: opts.IncludeCount,
-		Limit:        opts.Limit,
-		Page:         opts.Page,
-		c:            c,
-		lookupCache: &iteratorCache{
-			authors:   make(map[string]*Author),
-			categorys: make(map[string]*Category),
-			posts:     make(map[string]*Post),
-		},
+	q.set("fields.date[in]", strings.Join(values, ","))
+	return q
+}
+
+// DateNotIn excludes entries matching any of the given values
+func (q *PostQueryBuilder) DateNotIn(vs ...time.Time) *PostQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = v.Format(time.RFC3339)
 	}
+	q.set("fields.date[nin]", strings.Join(values, ","))
+	return q
+}
+
+// DateLT filters entries with a value less than the given one
+func (q *PostQueryBuilder) DateLT(v time.Time) *PostQueryBuilder {
+	q.set("fields.date[lt]", v.Format(time.RFC3339))
+	return q
+}
+
+// DateLTE filters entries with a value less than or equal to the given one
+func (q *PostQueryBuilder) DateLTE(v time.Time) *PostQueryBuilder {
+	q.set("fields.date[lte]", v.Format(time.RFC3339))
+	return q
+}
+
+// DateGT filters entries with a value greater than the given one
+func (q *PostQueryBuilder) DateGT(v time.Time) *PostQueryBuilder {
+	q.set("fields.date[gt]", v.Format(time.RFC3339))
+	return q
+}
+
+// DateGTE filters entries with a value greater than or equal to the given one
+func (q *PostQueryBuilder) DateGTE(v time.Time) *PostQueryBuilder {
+	q.set("fields.date[gte]", v.Format(time.RFC3339))
+	return q
+}
+
+// DateExists filters entries by whether Date is set
+func (q *PostQueryBuilder) DateExists(v bool) *PostQueryBuilder {
+	q.set("fields.date[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByDateAsc sorts entries by Date in ascending order
+func (q *PostQueryBuilder) OrderByDateAsc() *PostQueryBuilder {
+	q.order = append(q.order, "fields.date")
+	return q
+}
+
+// OrderByDateDesc sorts entries by Date in descending order
+func (q *PostQueryBuilder) OrderByDateDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-fields.date")
+	return q
+}
+
+// CommentsEquals filters entries by an exact value
+func (q *PostQueryBuilder) CommentsEquals(v bool) *PostQueryBuilder {
+	q.set("fields.comments", strconv.FormatBool(v))
+	return q
+}
+
+// CommentsExists filters entries by whether Comments is set
+func (q *PostQueryBuilder) CommentsExists(v bool) *PostQueryBuilder {
+	q.set("fields.comments[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByCommentsAsc sorts entries by Comments in ascending order
+func (q *PostQueryBuilder) OrderByCommentsAsc() *PostQueryBuilder {
+	q.order = append(q.order, "fields.comments")
+	return q
+}
+
+// OrderByCommentsDesc sorts entries by Comments in descending order
+func (q *PostQueryBuilder) OrderByCommentsDesc() *PostQueryBuilder {
+	q.order = append(q.order, "-fields.comments")
+	return q
+}
+
+// ApproverIDEquals filters entries linking to the given ID
+func (q *PostQueryBuilder) ApproverIDEquals(v string) *PostQueryBuilder {
+	q.set("fields.approver.sys.id", v)
+	return q
+}
+
+// ApproverIDIn filters entries linking to any of the given IDs
+func (q *PostQueryBuilder) ApproverIDIn(vs ...string) *PostQueryBuilder {
+	q.set("fields.approver.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// ApproverExists filters entries by whether Approver is set
+func (q *PostQueryBuilder) ApproverExists(v bool) *PostQueryBuilder {
+	q.set("fields.approver[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// AuthorOrPostContains filters entries linking to the given ID
+func (q *PostQueryBuilder) AuthorOrPostContains(v string) *PostQueryBuilder {
+	q.set("fields.authorOrPost.sys.id", v)
+	return q
+}
+
+// AuthorOrPostContainsAny filters entries linking to any of the given IDs
+func (q *PostQueryBuilder) AuthorOrPostContainsAny(vs ...string) *PostQueryBuilder {
+	q.set("fields.authorOrPost.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// AuthorOrPostExists filters entries by whether AuthorOrPost is set
+func (q *PostQueryBuilder) AuthorOrPostExists(v bool) *PostQueryBuilder {
+	q.set("fields.authorOrPost[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// QueryPosts retrieves paginated Post entries matching the given query
+func (c *ContentClient) QueryPosts(q *PostQueryBuilder, opts ListOptions) *PostIterator {
+	it := c.Posts(opts)
+	it.query = q.encode()
 	return it
 }
 
-// CategoryIterator is used to paginate result sets of Category
-type CategoryIterator struct {
-	Page         int
+// PostAuthorOrPostItem is implemented by the content types allowed in Post.AuthorOrPost
+type PostAuthorOrPostItem interface {
+	visitPostAuthorOrPostItem(PostAuthorOrPostItemVisitor)
+}
+
+// PostAuthorOrPostItemVisitor handles every content type allowed in Post.AuthorOrPost
+type PostAuthorOrPostItemVisitor interface {
+	VisitAuthor(Author)
+	VisitPost(Post)
+}
+
+func (e Author) visitPostAuthorOrPostItem(v PostAuthorOrPostItemVisitor) {
+	v.VisitAuthor(e)
+}
+func (e Post) visitPostAuthorOrPostItem(v PostAuthorOrPostItemVisitor) {
+	v.VisitPost(e)
+}
+
+// VisitPostAuthorOrPostItem calls the visitor method matching the content type of item. Nil items are ignored
+func VisitPostAuthorOrPostItem(item PostAuthorOrPostItem, v PostAuthorOrPostItemVisitor) {
+	if item != nil {
+		item.visitPostAuthorOrPostItem(v)
+	}
+}
+
+// AuthorIterator is used to paginate result sets of Author
+type AuthorIterator struct {
 	Limit        int
 	Offset       int
+	total        int
+	fetched      bool
 	IncludeCount int
 	c            *ContentClient
-	items        []*Category
+	items        []*Author
 	lookupCache  *iteratorCache
+	locale       string
+	query        string
+	pageLimit    int
+	prefetch     *prefetcher[*authorResponse]
+	errors       []IncludeError
 }
 
-// Next returns the following item of type Category. If none exists a network request will be executed
-func (it *CategoryIterator) Next() (*Category, error) {
+// setPage records a fetched page in the pagination state
+func (it *AuthorIterator) setPage(items []*Author, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type Author. If none exists a network request will be executed
+func (it *AuthorIterator) Next() (*Author, error) {
 	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
 		if err := it.fetch(); err != nil {
 			return nil, err
 		}
@@ -510,328 +1094,5749 @@ func (it *CategoryIterator) Next() (*Category, error) {
 	if len(it.items) == 0 {
 		return nil, ErrIteratorDone
 	}
-	var item *Category
-	item, it.items = it.items[len(it.items)-1], it.items[:len(it.items)-1]
-	if len(it.items) == 0 {
-		it.Page++
-		it.Offset = it.Page * it.Limit
-	}
+	var item *Author
+	item, it.items = it.items[0], it.items[1:]
 	return item, nil
 }
-func (it *CategoryIterator) fetch() error {
-	c := it.c
-	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&include=%d&locale=%s&limit=%d&skip=%d", c.host, c.spaceID, c.authToken, "5KMiN6YPvi42icqAUQMCQe", it.IncludeCount, c.Locale, it.Limit, it.Offset)
-	resp, err := c.client.Get(url)
-	if err != nil {
-		return err
+
+// Page returns the remaining items of the current page of Author. If none exists a network request will be executed
+func (it *AuthorIterator) Page() ([]*Author, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
 	}
-	var data categoryResponse
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return err
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining Author items. Iteration stops after the first error
+func (it *AuthorIterator) All() iter.Seq2[*Author, error] {
+	return func(yield func(*Author, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
 	}
-	if err := resp.Body.Close(); err != nil {
-		return err
+}
+
+// Collect returns up to max remaining Author items. A max of zero or less collects all items
+func (it *AuthorIterator) Collect(max int) ([]*Author, error) {
+	var items []*Author
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
 	}
-	var items = make([]*Category, len(data.Items))
-	for i, raw := range data.Items {
-		var item categoryItem
-		if err := json.Unmarshal(*raw.Fields, &item.Fields); err != nil {
+	return items, nil
+}
+
+// ForEach calls fn for every remaining Author item and stops at the first error
+func (it *AuthorIterator) ForEach(fn func(*Author) error) error {
+	for item, err := range it.All() {
+		if err != nil {
 			return err
 		}
-		items[i] = &Category{
-			ID:               raw.Sys.ID,
-			Icon:             resolveAsset(item.Fields.Icon.Sys.ID, data.Includes),
-			Parent:           resolveCategoryPtr(item.Fields.Parent.Sys.ID, data.Items, data.Includes, it.lookupCache),
-			ShortDescription: item.Fields.ShortDescription,
-			Title:            item.Fields.Title,
+		if err := fn(item); err != nil {
+			return err
 		}
 	}
-	it.items = items
 	return nil
 }
 
-// Category has no description in contentful
-type Category struct {
-	ID               string
-	Title            string
-	ShortDescription string
-	Icon             Asset
-	Parent           *Category
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *AuthorIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
 }
 
-// categoryItem contains a single contentful Category model
-type categoryItem struct {
-	Sys    sys `json:"sys"`
-	Fields struct {
-		Title            string  `json:"title"`
-		ShortDescription string  `json:"shortDescription"`
-		Icon             entryID `json:"icon"`
-		Parent           entryID `json:"parent"`
-	} `json:"fields"`
+// HasMore reports whether Next can return further items of type Author
+func (it *AuthorIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
 }
 
-// categoryResponse holds an entire contentful Category response
-type categoryResponse struct {
-	Total    int            `json:"total"`
-	Skip     int            `json:"skip"`
-	Limit    int            `json:"limit"`
-	Items    []includeEntry `json:"items"`
-	Includes includes       `json:"includes"`
+// Errors returns the links contentful reported as unresolvable in the pages fetched so far
+func (it *AuthorIterator) Errors() []IncludeError {
+	return it.errors
+}
+func (it *AuthorIterator) params(offset, limit int) string {
+	params := fmt.Sprintf("include=%d&limit=%d&skip=%d", it.IncludeCount, limit, offset)
+	if it.query != "" {
+		params += "&" + it.query
+	}
+	return params
+}
+func (it *AuthorIterator) fetch() error {
+	if it.pageLimit <= 0 || it.pageLimit > it.Limit {
+		it.pageLimit = it.Limit
+	}
+	data, ok, err := it.prefetch.take()
+	if !ok {
+		data, err = it.c.requestAuthors(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	// contentful rejects large responses, retry with smaller pages
+	for errors.Is(err, ErrResponseTooBig) && it.pageLimit > 1 {
+		it.pageLimit /= 2
+		data, err = it.c.requestAuthors(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	if err != nil {
+		return err
+	}
+	items, err := it.c.decodeAuthors(data, it.lookupCache)
+	if err != nil {
+		return err
+	}
+	it.errors = append(it.errors, data.Errors...)
+	it.setPage(items, data.Total)
+	if it.pageLimit < it.Limit {
+		it.pageLimit = min(it.pageLimit*2, it.Limit)
+	}
+	limit := it.pageLimit
+	it.prefetch.fill(it.Offset, limit, it.total, func(offset int) (*authorResponse, error) {
+		return it.c.requestAuthors(it.locale, it.params(offset, limit))
+	})
+	return nil
 }
 
-func resolveCategory(entryID string, items []includeEntry, includes includes, cache *iteratorCache) Category {
-	if v, ok := cache.categorys[entryID]; ok {
-		return *v
+// fetchAuthors requests and resolves Author entries matching the given query parameters
+func (c *ContentClient) fetchAuthors(params string, cache *iteratorCache) ([]*Author, int, error) {
+	data, err := c.requestAuthors(cache.locale, params)
+	if err != nil {
+		return nil, 0, err
 	}
-	var item categoryItem
-	for _, entry := range append(includes.Entries, items...) {
-		if entry.Sys.ID == entryID {
-			if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-				return Category{}
+	items, err := c.decodeAuthors(data, cache)
+	return items, data.Total, err
+}
+
+// requestAuthors requests a raw page of Author entries matching the given query parameters
+func (c *ContentClient) requestAuthors(locale string, params string) (*authorResponse, error) {
+	chain := c.fallbackChain(locale)
+	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&locale=%s&%s", c.host, c.spaceID, c.authToken, "1kUEViTN4EmGiEaaeC6ouY", localeQuery(chain), params)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data authorResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// decodeAuthors resolves the Author entries of a raw response
+func (c *ContentClient) decodeAuthors(data *authorResponse, cache *iteratorCache) ([]*Author, error) {
+	index := newLinkIndex(data.Items, data.Includes)
+	if c.fetchLinks {
+		if err := c.completeLinks(index, cache); err != nil {
+			return nil, err
+		}
+	}
+	var items = make([]*Author, len(data.Items))
+	for i, raw := range data.Items {
+		var item authorItem
+		if err := json.Unmarshal(*raw.Fields, &item.Fields); err != nil {
+			return nil, err
+		}
+		entry := Author{
+			Age:            item.Fields.Age,
+			Biography:      item.Fields.Biography,
+			CreatedEntries: resolvePosts(item.Fields.CreatedEntries, index, cache),
+			ID:             raw.Sys.ID,
+			Name:           item.Fields.Name,
+			ProfilePhoto:   resolveAsset(item.Fields.ProfilePhoto.Sys.ID, index, cache),
+			Rating:         item.Fields.Rating,
+			Website:        item.Fields.Website,
+		}
+		cache.shared.setEntry(&raw)
+		items[i] = &entry
+	}
+	return items, nil
+}
+
+// Author retrieves a single Author entry by its ID
+func (c *ContentClient) Author(id string) (*Author, error) {
+	// entries in the client cache resolve without a request
+	if entry, ok := resolveAuthor(id, newLinkIndex(nil, includes{}), c.newCache()); ok {
+		return &entry, nil
+	}
+	items, _, err := c.fetchAuthors(fmt.Sprintf("include=%d&limit=1&sys.id=%s", maxIncludeDepth, url.QueryEscape(id)), c.newCache())
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, &NotFoundError{
+			ContentType: "1kUEViTN4EmGiEaaeC6ouY",
+			ID:          id,
+		}
+	}
+	return items[0], nil
+}
+
+// Author a
+type Author struct {
+	ID             string
+	Name           string
+	Website        string
+	ProfilePhoto   Ref[Asset]
+	Biography      string
+	CreatedEntries []Ref[Post]
+	Age            int64
+	Rating         float64
+}
+
+// authorItem contains a single contentful Author model
+type authorItem struct {
+	Sys    sys `json:"sys"`
+	Fields struct {
+		Name           string   `json:"name"`
+		Website        string   `json:"website"`
+		ProfilePhoto   entryID  `json:"profilePhoto"`
+		Biography      string   `json:"biography"`
+		CreatedEntries entryIDs `json:"createdEntries"`
+		Age            int64    `json:"age"`
+		Rating         float64  `json:"rating"`
+	} `json:"fields"`
+}
+
+// authorResponse holds an entire contentful Author response
+type authorResponse struct {
+	Total    int            `json:"total"`
+	Skip     int            `json:"skip"`
+	Limit    int            `json:"limit"`
+	Items    []includeEntry `json:"items"`
+	Includes includes       `json:"includes"`
+	Errors   []IncludeError `json:"errors"`
+}
+
+func resolveAuthor(entryID string, index *linkIndex, cache *iteratorCache) (Author, bool) {
+	if v, ok := cache.authors[entryID]; ok {
+		return *v, true
+	}
+	entry, cached := cache.shared.entry(entryID)
+	if !cached {
+		entry = index.entries[entryID]
+	}
+	if entry == nil || entry.Sys.ContentType.Sys.ID != "1kUEViTN4EmGiEaaeC6ouY" {
+		return Author{}, false
+	}
+	var item authorItem
+	if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+		return Author{}, false
+	}
+	var tmp = &Author{
+		Age:       item.Fields.Age,
+		Biography: item.Fields.Biography,
+		ID:        entry.Sys.ID,
+		Name:      item.Fields.Name,
+		Rating:    item.Fields.Rating,
+		Website:   item.Fields.Website,
+	}
+	cache.authors[entry.Sys.ID] = tmp
+	tmp.CreatedEntries = resolvePosts(item.Fields.CreatedEntries, index, cache)
+	tmp.ProfilePhoto = resolveAsset(item.Fields.ProfilePhoto.Sys.ID, index, cache)
+	if !cached {
+		cache.shared.setEntry(entry)
+	}
+	return *tmp, true
+}
+func resolveAuthorRef(entryID string, index *linkIndex, cache *iteratorCache) Ref[Author] {
+	ref := Ref[Author]{ID: entryID}
+	ref.Value, ref.Resolved = resolveAuthor(entryID, index, cache)
+	return ref
+}
+func resolveAuthorPtr(entryID string, index *linkIndex, cache *iteratorCache) Ref[*Author] {
+	ref := Ref[*Author]{ID: entryID}
+	if item, ok := resolveAuthor(entryID, index, cache); ok {
+		ref.Value = &item
+		ref.Resolved = true
+	}
+	return ref
+}
+func resolveAuthorsPtr(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[*Author] {
+	var refs []Ref[*Author]
+	for _, entryID := range ids {
+		refs = append(refs, resolveAuthorPtr(entryID.Sys.ID, index, cache))
+	}
+	return refs
+}
+func resolveAuthors(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[Author] {
+	var refs []Ref[Author]
+	for _, entryID := range ids {
+		refs = append(refs, resolveAuthorRef(entryID.Sys.ID, index, cache))
+	}
+	return refs
+}
+
+// Authors retrieves paginated Author entries
+func (c *ContentClient) Authors(opts ListOptions) *AuthorIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	if opts.Locale == "" {
+		opts.Locale = c.Locale
+	}
+	it := &AuthorIterator{
+		IncludeCount: opts.IncludeCount,
+		Limit:        opts.Limit,
+		Offset:       opts.Page * opts.Limit,
+		c:            c,
+		locale:       opts.Locale,
+		lookupCache:  c.newLocaleCache(opts.Locale),
+		query:        AuthorQuery().encode(),
+	}
+	if opts.Prefetch > 0 {
+		it.prefetch = &prefetcher[*authorResponse]{pages: opts.Prefetch}
+	}
+	return it
+}
+
+// AuthorField names a Author field which can be selected in queries
+type AuthorField string
+
+const (
+	AuthorFieldName           AuthorField = "fields.name"
+	AuthorFieldWebsite        AuthorField = "fields.website"
+	AuthorFieldProfilePhoto   AuthorField = "fields.profilePhoto"
+	AuthorFieldBiography      AuthorField = "fields.biography"
+	AuthorFieldCreatedEntries AuthorField = "fields.createdEntries"
+	AuthorFieldAge            AuthorField = "fields.age"
+	AuthorFieldRating         AuthorField = "fields.rating"
+)
+
+// AuthorQueryBuilder builds filtered and ordered queries for Author entries
+type AuthorQueryBuilder struct {
+	queryBuilder
+}
+
+// AuthorQuery returns an empty query for Author entries
+func AuthorQuery() *AuthorQueryBuilder {
+	return &AuthorQueryBuilder{}
+}
+
+// Search filters entries by a full text search across all text fields
+func (q *AuthorQueryBuilder) Search(text string) *AuthorQueryBuilder {
+	q.set("query", text)
+	return q
+}
+
+// Select restricts the returned Author fields
+func (q *AuthorQueryBuilder) Select(fields ...AuthorField) *AuthorQueryBuilder {
+	for _, field := range fields {
+		q.fields = append(q.fields, string(field))
+	}
+	return q
+}
+
+// OrderByCreatedAtAsc sorts entries by CreatedAt in ascending order
+func (q *AuthorQueryBuilder) OrderByCreatedAtAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "sys.createdAt")
+	return q
+}
+
+// OrderByCreatedAtDesc sorts entries by CreatedAt in descending order
+func (q *AuthorQueryBuilder) OrderByCreatedAtDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-sys.createdAt")
+	return q
+}
+
+// OrderByUpdatedAtAsc sorts entries by UpdatedAt in ascending order
+func (q *AuthorQueryBuilder) OrderByUpdatedAtAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "sys.updatedAt")
+	return q
+}
+
+// OrderByUpdatedAtDesc sorts entries by UpdatedAt in descending order
+func (q *AuthorQueryBuilder) OrderByUpdatedAtDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-sys.updatedAt")
+	return q
+}
+
+// NameEquals filters entries by an exact value
+func (q *AuthorQueryBuilder) NameEquals(v string) *AuthorQueryBuilder {
+	q.set("fields.name", v)
+	return q
+}
+
+// NameNotEquals excludes entries with the given value
+func (q *AuthorQueryBuilder) NameNotEquals(v string) *AuthorQueryBuilder {
+	q.set("fields.name[ne]", v)
+	return q
+}
+
+// NameIn filters entries matching any of the given values
+func (q *AuthorQueryBuilder) NameIn(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.name[in]", strings.Join(vs, ","))
+	return q
+}
+
+// NameNotIn excludes entries matching any of the given values
+func (q *AuthorQueryBuilder) NameNotIn(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.name[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// NameMatch filters entries by a full text search on this field
+func (q *AuthorQueryBuilder) NameMatch(v string) *AuthorQueryBuilder {
+	q.set("fields.name[match]", v)
+	return q
+}
+
+// NameExists filters entries by whether Name is set
+func (q *AuthorQueryBuilder) NameExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.name[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByNameAsc sorts entries by Name in ascending order
+func (q *AuthorQueryBuilder) OrderByNameAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "fields.name")
+	return q
+}
+
+// OrderByNameDesc sorts entries by Name in descending order
+func (q *AuthorQueryBuilder) OrderByNameDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-fields.name")
+	return q
+}
+
+// WebsiteEquals filters entries by an exact value
+func (q *AuthorQueryBuilder) WebsiteEquals(v string) *AuthorQueryBuilder {
+	q.set("fields.website", v)
+	return q
+}
+
+// WebsiteNotEquals excludes entries with the given value
+func (q *AuthorQueryBuilder) WebsiteNotEquals(v string) *AuthorQueryBuilder {
+	q.set("fields.website[ne]", v)
+	return q
+}
+
+// WebsiteIn filters entries matching any of the given values
+func (q *AuthorQueryBuilder) WebsiteIn(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.website[in]", strings.Join(vs, ","))
+	return q
+}
+
+// WebsiteNotIn excludes entries matching any of the given values
+func (q *AuthorQueryBuilder) WebsiteNotIn(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.website[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// WebsiteMatch filters entries by a full text search on this field
+func (q *AuthorQueryBuilder) WebsiteMatch(v string) *AuthorQueryBuilder {
+	q.set("fields.website[match]", v)
+	return q
+}
+
+// WebsiteExists filters entries by whether Website is set
+func (q *AuthorQueryBuilder) WebsiteExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.website[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByWebsiteAsc sorts entries by Website in ascending order
+func (q *AuthorQueryBuilder) OrderByWebsiteAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "fields.website")
+	return q
+}
+
+// OrderByWebsiteDesc sorts entries by Website in descending order
+func (q *AuthorQueryBuilder) OrderByWebsiteDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-fields.website")
+	return q
+}
+
+// ProfilePhotoIDEquals filters entries linking to the given ID
+func (q *AuthorQueryBuilder) ProfilePhotoIDEquals(v string) *AuthorQueryBuilder {
+	q.set("fields.profilePhoto.sys.id", v)
+	return q
+}
+
+// ProfilePhotoIDIn filters entries linking to any of the given IDs
+func (q *AuthorQueryBuilder) ProfilePhotoIDIn(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.profilePhoto.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// ProfilePhotoExists filters entries by whether ProfilePhoto is set
+func (q *AuthorQueryBuilder) ProfilePhotoExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.profilePhoto[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// BiographyMatch filters entries by a full text search on this field
+func (q *AuthorQueryBuilder) BiographyMatch(v string) *AuthorQueryBuilder {
+	q.set("fields.biography[match]", v)
+	return q
+}
+
+// BiographyExists filters entries by whether Biography is set
+func (q *AuthorQueryBuilder) BiographyExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.biography[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// CreatedEntriesContains filters entries linking to the given ID
+func (q *AuthorQueryBuilder) CreatedEntriesContains(v string) *AuthorQueryBuilder {
+	q.set("fields.createdEntries.sys.id", v)
+	return q
+}
+
+// CreatedEntriesContainsAny filters entries linking to any of the given IDs
+func (q *AuthorQueryBuilder) CreatedEntriesContainsAny(vs ...string) *AuthorQueryBuilder {
+	q.set("fields.createdEntries.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// CreatedEntriesExists filters entries by whether CreatedEntries is set
+func (q *AuthorQueryBuilder) CreatedEntriesExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.createdEntries[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// AgeEquals filters entries by an exact value
+func (q *AuthorQueryBuilder) AgeEquals(v int64) *AuthorQueryBuilder {
+	q.set("fields.age", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeNotEquals excludes entries with the given value
+func (q *AuthorQueryBuilder) AgeNotEquals(v int64) *AuthorQueryBuilder {
+	q.set("fields.age[ne]", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeIn filters entries matching any of the given values
+func (q *AuthorQueryBuilder) AgeIn(vs ...int64) *AuthorQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = strconv.FormatInt(v, 10)
+	}
+	q.set("fields.age[in]", strings.Join(values, ","))
+	return q
+}
+
+// AgeNotIn excludes entries matching any of the given values
+func (q *AuthorQueryBuilder) AgeNotIn(vs ...int64) *AuthorQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = strconv.FormatInt(v, 10)
+	}
+	q.set("fields.age[nin]", strings.Join(values, ","))
+	return q
+}
+
+// AgeLT filters entries with a value less than the given one
+func (q *AuthorQueryBuilder) AgeLT(v int64) *AuthorQueryBuilder {
+	q.set("fields.age[lt]", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeLTE filters entries with a value less than or equal to the given one
+func (q *AuthorQueryBuilder) AgeLTE(v int64) *AuthorQueryBuilder {
+	q.set("fields.age[lte]", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeGT filters entries with a value greater than the given one
+func (q *AuthorQueryBuilder) AgeGT(v int64) *AuthorQueryBuilder {
+	q.set("fields.age[gt]", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeGTE filters entries with a value greater than or equal to the given one
+func (q *AuthorQueryBuilder) AgeGTE(v int64) *AuthorQueryBuilder {
+	q.set("fields.age[gte]", strconv.FormatInt(v, 10))
+	return q
+}
+
+// AgeExists filters entries by whether Age is set
+func (q *AuthorQueryBuilder) AgeExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.age[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByAgeAsc sorts entries by Age in ascending order
+func (q *AuthorQueryBuilder) OrderByAgeAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "fields.age")
+	return q
+}
+
+// OrderByAgeDesc sorts entries by Age in descending order
+func (q *AuthorQueryBuilder) OrderByAgeDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-fields.age")
+	return q
+}
+
+// RatingEquals filters entries by an exact value
+func (q *AuthorQueryBuilder) RatingEquals(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingNotEquals excludes entries with the given value
+func (q *AuthorQueryBuilder) RatingNotEquals(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating[ne]", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingIn filters entries matching any of the given values
+func (q *AuthorQueryBuilder) RatingIn(vs ...float64) *AuthorQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = strconv.FormatFloat(v, byte(0x66), -1, 64)
+	}
+	q.set("fields.rating[in]", strings.Join(values, ","))
+	return q
+}
+
+// RatingNotIn excludes entries matching any of the given values
+func (q *AuthorQueryBuilder) RatingNotIn(vs ...float64) *AuthorQueryBuilder {
+	values := make([]string, len(vs))
+	for i, v := range vs {
+		values[i] = strconv.FormatFloat(v, byte(0x66), -1, 64)
+	}
+	q.set("fields.rating[nin]", strings.Join(values, ","))
+	return q
+}
+
+// RatingLT filters entries with a value less than the given one
+func (q *AuthorQueryBuilder) RatingLT(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating[lt]", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingLTE filters entries with a value less than or equal to the given one
+func (q *AuthorQueryBuilder) RatingLTE(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating[lte]", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingGT filters entries with a value greater than the given one
+func (q *AuthorQueryBuilder) RatingGT(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating[gt]", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingGTE filters entries with a value greater than or equal to the given one
+func (q *AuthorQueryBuilder) RatingGTE(v float64) *AuthorQueryBuilder {
+	q.set("fields.rating[gte]", strconv.FormatFloat(v, byte(0x66), -1, 64))
+	return q
+}
+
+// RatingExists filters entries by whether Rating is set
+func (q *AuthorQueryBuilder) RatingExists(v bool) *AuthorQueryBuilder {
+	q.set("fields.rating[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByRatingAsc sorts entries by Rating in ascending order
+func (q *AuthorQueryBuilder) OrderByRatingAsc() *AuthorQueryBuilder {
+	q.order = append(q.order, "fields.rating")
+	return q
+}
+
+// OrderByRatingDesc sorts entries by Rating in descending order
+func (q *AuthorQueryBuilder) OrderByRatingDesc() *AuthorQueryBuilder {
+	q.order = append(q.order, "-fields.rating")
+	return q
+}
+
+// QueryAuthors retrieves paginated Author entries matching the given query
+func (c *ContentClient) QueryAuthors(q *AuthorQueryBuilder, opts ListOptions) *AuthorIterator {
+	it := c.Authors(opts)
+	it.query = q.encode()
+	return it
+}
+
+// CategoryIterator is used to paginate result sets of Category
+type CategoryIterator struct {
+	Limit        int
+	Offset       int
+	total        int
+	fetched      bool
+	IncludeCount int
+	c            *ContentClient
+	items        []*Category
+	lookupCache  *iteratorCache
+	locale       string
+	query        string
+	pageLimit    int
+	prefetch     *prefetcher[*categoryResponse]
+	errors       []IncludeError
+}
+
+// setPage records a fetched page in the pagination state
+func (it *CategoryIterator) setPage(items []*Category, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type Category. If none exists a network request will be executed
+func (it *CategoryIterator) Next() (*Category, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *Category
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of Category. If none exists a network request will be executed
+func (it *CategoryIterator) Page() ([]*Category, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining Category items. Iteration stops after the first error
+func (it *CategoryIterator) All() iter.Seq2[*Category, error] {
+	return func(yield func(*Category, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining Category items. A max of zero or less collects all items
+func (it *CategoryIterator) Collect(max int) ([]*Category, error) {
+	var items []*Category
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining Category item and stops at the first error
+func (it *CategoryIterator) ForEach(fn func(*Category) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *CategoryIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type Category
+func (it *CategoryIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+
+// Errors returns the links contentful reported as unresolvable in the pages fetched so far
+func (it *CategoryIterator) Errors() []IncludeError {
+	return it.errors
+}
+func (it *CategoryIterator) params(offset, limit int) string {
+	params := fmt.Sprintf("include=%d&limit=%d&skip=%d", it.IncludeCount, limit, offset)
+	if it.query != "" {
+		params += "&" + it.query
+	}
+	return params
+}
+func (it *CategoryIterator) fetch() error {
+	if it.pageLimit <= 0 || it.pageLimit > it.Limit {
+		it.pageLimit = it.Limit
+	}
+	data, ok, err := it.prefetch.take()
+	if !ok {
+		data, err = it.c.requestCategories(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	// contentful rejects large responses, retry with smaller pages
+	for errors.Is(err, ErrResponseTooBig) && it.pageLimit > 1 {
+		it.pageLimit /= 2
+		data, err = it.c.requestCategories(it.locale, it.params(it.Offset, it.pageLimit))
+	}
+	if err != nil {
+		return err
+	}
+	items, err := it.c.decodeCategories(data, it.lookupCache)
+	if err != nil {
+		return err
+	}
+	it.errors = append(it.errors, data.Errors...)
+	it.setPage(items, data.Total)
+	if it.pageLimit < it.Limit {
+		it.pageLimit = min(it.pageLimit*2, it.Limit)
+	}
+	limit := it.pageLimit
+	it.prefetch.fill(it.Offset, limit, it.total, func(offset int) (*categoryResponse, error) {
+		return it.c.requestCategories(it.locale, it.params(offset, limit))
+	})
+	return nil
+}
+
+// fetchCategories requests and resolves Category entries matching the given query parameters
+func (c *ContentClient) fetchCategories(params string, cache *iteratorCache) ([]*Category, int, error) {
+	data, err := c.requestCategories(cache.locale, params)
+	if err != nil {
+		return nil, 0, err
+	}
+	items, err := c.decodeCategories(data, cache)
+	return items, data.Total, err
+}
+
+// requestCategories requests a raw page of Category entries matching the given query parameters
+func (c *ContentClient) requestCategories(locale string, params string) (*categoryResponse, error) {
+	chain := c.fallbackChain(locale)
+	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&content_type=%s&locale=%s&%s", c.host, c.spaceID, c.authToken, "5KMiN6YPvi42icqAUQMCQe", localeQuery(chain), params)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data categoryResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// decodeCategories resolves the Category entries of a raw response
+func (c *ContentClient) decodeCategories(data *categoryResponse, cache *iteratorCache) ([]*Category, error) {
+	index := newLinkIndex(data.Items, data.Includes)
+	if c.fetchLinks {
+		if err := c.completeLinks(index, cache); err != nil {
+			return nil, err
+		}
+	}
+	var items = make([]*Category, len(data.Items))
+	for i, raw := range data.Items {
+		var item categoryItem
+		if err := json.Unmarshal(*raw.Fields, &item.Fields); err != nil {
+			return nil, err
+		}
+		entry := Category{
+			ID:               raw.Sys.ID,
+			Icon:             resolveAsset(item.Fields.Icon.Sys.ID, index, cache),
+			Parent:           resolveCategoryPtr(item.Fields.Parent.Sys.ID, index, cache),
+			ShortDescription: item.Fields.ShortDescription,
+			Title:            item.Fields.Title,
+		}
+		cache.shared.setEntry(&raw)
+		items[i] = &entry
+	}
+	return items, nil
+}
+
+// Category retrieves a single Category entry by its ID
+func (c *ContentClient) Category(id string) (*Category, error) {
+	// entries in the client cache resolve without a request
+	if entry, ok := resolveCategory(id, newLinkIndex(nil, includes{}), c.newCache()); ok {
+		return &entry, nil
+	}
+	items, _, err := c.fetchCategories(fmt.Sprintf("include=%d&limit=1&sys.id=%s", maxIncludeDepth, url.QueryEscape(id)), c.newCache())
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, &NotFoundError{
+			ContentType: "5KMiN6YPvi42icqAUQMCQe",
+			ID:          id,
+		}
+	}
+	return items[0], nil
+}
+
+// Category has no description in contentful
+type Category struct {
+	ID               string
+	Title            string
+	ShortDescription string
+	Icon             Ref[Asset]
+	Parent           Ref[*Category]
+}
+
+// categoryItem contains a single contentful Category model
+type categoryItem struct {
+	Sys    sys `json:"sys"`
+	Fields struct {
+		Title            string  `json:"title"`
+		ShortDescription string  `json:"shortDescription"`
+		Icon             entryID `json:"icon"`
+		Parent           entryID `json:"parent"`
+	} `json:"fields"`
+}
+
+// categoryResponse holds an entire contentful Category response
+type categoryResponse struct {
+	Total    int            `json:"total"`
+	Skip     int            `json:"skip"`
+	Limit    int            `json:"limit"`
+	Items    []includeEntry `json:"items"`
+	Includes includes       `json:"includes"`
+	Errors   []IncludeError `json:"errors"`
+}
+
+func resolveCategory(entryID string, index *linkIndex, cache *iteratorCache) (Category, bool) {
+	if v, ok := cache.categorys[entryID]; ok {
+		return *v, true
+	}
+	entry, cached := cache.shared.entry(entryID)
+	if !cached {
+		entry = index.entries[entryID]
+	}
+	if entry == nil || entry.Sys.ContentType.Sys.ID != "5KMiN6YPvi42icqAUQMCQe" {
+		return Category{}, false
+	}
+	var item categoryItem
+	if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+		return Category{}, false
+	}
+	var tmp = &Category{
+		ID:               entry.Sys.ID,
+		ShortDescription: item.Fields.ShortDescription,
+		Title:            item.Fields.Title,
+	}
+	cache.categorys[entry.Sys.ID] = tmp
+	tmp.Icon = resolveAsset(item.Fields.Icon.Sys.ID, index, cache)
+	tmp.Parent = resolveCategoryPtr(item.Fields.Parent.Sys.ID, index, cache)
+	if !cached {
+		cache.shared.setEntry(entry)
+	}
+	return *tmp, true
+}
+func resolveCategoryRef(entryID string, index *linkIndex, cache *iteratorCache) Ref[Category] {
+	ref := Ref[Category]{ID: entryID}
+	ref.Value, ref.Resolved = resolveCategory(entryID, index, cache)
+	return ref
+}
+func resolveCategoryPtr(entryID string, index *linkIndex, cache *iteratorCache) Ref[*Category] {
+	ref := Ref[*Category]{ID: entryID}
+	if item, ok := resolveCategory(entryID, index, cache); ok {
+		ref.Value = &item
+		ref.Resolved = true
+	}
+	return ref
+}
+func resolveCategorysPtr(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[*Category] {
+	var refs []Ref[*Category]
+	for _, entryID := range ids {
+		refs = append(refs, resolveCategoryPtr(entryID.Sys.ID, index, cache))
+	}
+	return refs
+}
+func resolveCategorys(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[Category] {
+	var refs []Ref[Category]
+	for _, entryID := range ids {
+		refs = append(refs, resolveCategoryRef(entryID.Sys.ID, index, cache))
+	}
+	return refs
+}
+
+// Categories retrieves paginated Category entries
+func (c *ContentClient) Categories(opts ListOptions) *CategoryIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	if opts.Locale == "" {
+		opts.Locale = c.Locale
+	}
+	it := &CategoryIterator{
+		IncludeCount: opts.IncludeCount,
+		Limit:        opts.Limit,
+		Offset:       opts.Page * opts.Limit,
+		c:            c,
+		locale:       opts.Locale,
+		lookupCache:  c.newLocaleCache(opts.Locale),
+		query:        CategoryQuery().encode(),
+	}
+	if opts.Prefetch > 0 {
+		it.prefetch = &prefetcher[*categoryResponse]{pages: opts.Prefetch}
+	}
+	return it
+}
+
+// CategoryField names a Category field which can be selected in queries
+type CategoryField string
+
+const (
+	CategoryFieldTitle            CategoryField = "fields.title"
+	CategoryFieldShortDescription CategoryField = "fields.shortDescription"
+	CategoryFieldIcon             CategoryField = "fields.icon"
+	CategoryFieldParent           CategoryField = "fields.parent"
+)
+
+// CategoryQueryBuilder builds filtered and ordered queries for Category entries
+type CategoryQueryBuilder struct {
+	queryBuilder
+}
+
+// CategoryQuery returns an empty query for Category entries
+func CategoryQuery() *CategoryQueryBuilder {
+	return &CategoryQueryBuilder{}
+}
+
+// Search filters entries by a full text search across all text fields
+func (q *CategoryQueryBuilder) Search(text string) *CategoryQueryBuilder {
+	q.set("query", text)
+	return q
+}
+
+// Select restricts the returned Category fields
+func (q *CategoryQueryBuilder) Select(fields ...CategoryField) *CategoryQueryBuilder {
+	for _, field := range fields {
+		q.fields = append(q.fields, string(field))
+	}
+	return q
+}
+
+// OrderByCreatedAtAsc sorts entries by CreatedAt in ascending order
+func (q *CategoryQueryBuilder) OrderByCreatedAtAsc() *CategoryQueryBuilder {
+	q.order = append(q.order, "sys.createdAt")
+	return q
+}
+
+// OrderByCreatedAtDesc sorts entries by CreatedAt in descending order
+func (q *CategoryQueryBuilder) OrderByCreatedAtDesc() *CategoryQueryBuilder {
+	q.order = append(q.order, "-sys.createdAt")
+	return q
+}
+
+// OrderByUpdatedAtAsc sorts entries by UpdatedAt in ascending order
+func (q *CategoryQueryBuilder) OrderByUpdatedAtAsc() *CategoryQueryBuilder {
+	q.order = append(q.order, "sys.updatedAt")
+	return q
+}
+
+// OrderByUpdatedAtDesc sorts entries by UpdatedAt in descending order
+func (q *CategoryQueryBuilder) OrderByUpdatedAtDesc() *CategoryQueryBuilder {
+	q.order = append(q.order, "-sys.updatedAt")
+	return q
+}
+
+// TitleEquals filters entries by an exact value
+func (q *CategoryQueryBuilder) TitleEquals(v string) *CategoryQueryBuilder {
+	q.set("fields.title", v)
+	return q
+}
+
+// TitleNotEquals excludes entries with the given value
+func (q *CategoryQueryBuilder) TitleNotEquals(v string) *CategoryQueryBuilder {
+	q.set("fields.title[ne]", v)
+	return q
+}
+
+// TitleIn filters entries matching any of the given values
+func (q *CategoryQueryBuilder) TitleIn(vs ...string) *CategoryQueryBuilder {
+	q.set("fields.title[in]", strings.Join(vs, ","))
+	return q
+}
+
+// TitleNotIn excludes entries matching any of the given values
+func (q *CategoryQueryBuilder) TitleNotIn(vs ...string) *CategoryQueryBuilder {
+	q.set("fields.title[nin]", strings.Join(vs, ","))
+	return q
+}
+
+// TitleMatch filters entries by a full text search on this field
+func (q *CategoryQueryBuilder) TitleMatch(v string) *CategoryQueryBuilder {
+	q.set("fields.title[match]", v)
+	return q
+}
+
+// TitleExists filters entries by whether Title is set
+func (q *CategoryQueryBuilder) TitleExists(v bool) *CategoryQueryBuilder {
+	q.set("fields.title[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// OrderByTitleAsc sorts entries by Title in ascending order
+func (q *CategoryQueryBuilder) OrderByTitleAsc() *CategoryQueryBuilder {
+	q.order = append(q.order, "fields.title")
+	return q
+}
+
+// OrderByTitleDesc sorts entries by Title in descending order
+func (q *CategoryQueryBuilder) OrderByTitleDesc() *CategoryQueryBuilder {
+	q.order = append(q.order, "-fields.title")
+	return q
+}
+
+// ShortDescriptionMatch filters entries by a full text search on this field
+func (q *CategoryQueryBuilder) ShortDescriptionMatch(v string) *CategoryQueryBuilder {
+	q.set("fields.shortDescription[match]", v)
+	return q
+}
+
+// ShortDescriptionExists filters entries by whether ShortDescription is set
+func (q *CategoryQueryBuilder) ShortDescriptionExists(v bool) *CategoryQueryBuilder {
+	q.set("fields.shortDescription[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// IconIDEquals filters entries linking to the given ID
+func (q *CategoryQueryBuilder) IconIDEquals(v string) *CategoryQueryBuilder {
+	q.set("fields.icon.sys.id", v)
+	return q
+}
+
+// IconIDIn filters entries linking to any of the given IDs
+func (q *CategoryQueryBuilder) IconIDIn(vs ...string) *CategoryQueryBuilder {
+	q.set("fields.icon.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// IconExists filters entries by whether Icon is set
+func (q *CategoryQueryBuilder) IconExists(v bool) *CategoryQueryBuilder {
+	q.set("fields.icon[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// ParentIDEquals filters entries linking to the given ID
+func (q *CategoryQueryBuilder) ParentIDEquals(v string) *CategoryQueryBuilder {
+	q.set("fields.parent.sys.id", v)
+	return q
+}
+
+// ParentIDIn filters entries linking to any of the given IDs
+func (q *CategoryQueryBuilder) ParentIDIn(vs ...string) *CategoryQueryBuilder {
+	q.set("fields.parent.sys.id[in]", strings.Join(vs, ","))
+	return q
+}
+
+// ParentExists filters entries by whether Parent is set
+func (q *CategoryQueryBuilder) ParentExists(v bool) *CategoryQueryBuilder {
+	q.set("fields.parent[exists]", strconv.FormatBool(v))
+	return q
+}
+
+// QueryCategories retrieves paginated Category entries matching the given query
+func (c *ContentClient) QueryCategories(q *CategoryQueryBuilder, opts ListOptions) *CategoryIterator {
+	it := c.Categories(opts)
+	it.query = q.encode()
+	return it
+}
+
+// ErrIteratorDone is used to indicate that the iterator has no more data
+var ErrIteratorDone = errors.New("IteratorDone")
+
+// ListOptions contains pagination configuration for iterators. Prefetch is the number of pages entry iterators request ahead concurrently. Locale overrides the client locale if set
+type ListOptions struct {
+	Page         int
+	Limit        int
+	IncludeCount int
+	Prefetch     int
+	Locale       string
+}
+
+// pageResult holds a prefetched raw page
+type pageResult[T any] struct {
+	data T
+	err  error
+}
+
+// prefetcher requests the following pages of an iterator in the background while the current page is consumed
+type prefetcher[T any] struct {
+	pages   int
+	next    int
+	failed  bool
+	pending []chan pageResult[T]
+}
+
+// fill starts requests for up to p.pages pages following offset, stopping at total
+func (p *prefetcher[T]) fill(offset, limit, total int, request func(offset int) (T, error)) {
+	if p == nil || p.failed {
+		return
+	}
+	if p.next < offset {
+		p.next = offset
+	}
+	for len(p.pending) < p.pages && p.next < total {
+		ch := make(chan pageResult[T], 1)
+		go func(offset int) {
+			data, err := request(offset)
+			ch <- pageResult[T]{
+				data: data,
+				err:  err,
+			}
+		}(p.next)
+		p.pending = append(p.pending, ch)
+		p.next += limit
+	}
+}
+
+// take waits for the oldest pending page. ok is false if no page is pending
+func (p *prefetcher[T]) take() (data T, ok bool, err error) {
+	if p == nil || len(p.pending) == 0 {
+		return data, false, nil
+	}
+	result := <-p.pending[0]
+	p.pending = p.pending[1:]
+	if result.err != nil {
+		// stop prefetching, later pages are requested synchronously again
+		p.failed = true
+		p.pending = nil
+	}
+	return result.data, true, result.err
+}
+
+// linkIndex maps the IDs of all entries and assets of a single response for constant time link resolution
+type linkIndex struct {
+	entries map[string]*includeEntry
+	assets  map[string]*includeAsset
+}
+
+// newLinkIndex indexes the items and includes of a response once so links don't require scans
+func newLinkIndex(items []includeEntry, includes includes) *linkIndex {
+	index := &linkIndex{
+		assets:  make(map[string]*includeAsset, len(includes.Assets)),
+		entries: make(map[string]*includeEntry, len(items)+len(includes.Entries)),
+	}
+	for i := range items {
+		index.entries[items[i].Sys.ID] = &items[i]
+	}
+	for i := range includes.Entries {
+		index.entries[includes.Entries[i].Sys.ID] = &includes.Entries[i]
+	}
+	for i := range includes.Assets {
+		index.assets[includes.Assets[i].Sys.ID] = &includes.Assets[i]
+	}
+	return index
+}
+func resolveAsset(assetID string, index *linkIndex, cache *iteratorCache) Ref[Asset] {
+	ref := Ref[Asset]{ID: assetID}
+	if asset, ok := cache.shared.asset(assetID); ok {
+		ref.Value, ref.Resolved = toAsset(*asset), true
+		return ref
+	}
+	if asset, ok := index.assets[assetID]; ok {
+		ref.Value = toAsset(*asset)
+		ref.Resolved = true
+		cache.shared.setAsset(asset)
+	}
+	return ref
+}
+
+// resolveEntries resolves multi type links in field order, keeping repeated references
+func resolveEntries(ids entryIDs, index *linkIndex, cache *iteratorCache) []Ref[Entry] {
+	var refs []Ref[Entry]
+	for _, id := range ids {
+		refs = append(refs, resolveEntry(id, index, cache))
+	}
+	return refs
+}
+func resolveEntry(id entryID, index *linkIndex, cache *iteratorCache) Ref[Entry] {
+	ref := Ref[Entry]{ID: id.Sys.ID}
+	entry, ok := index.entries[id.Sys.ID]
+	if !ok {
+		// entries resolved for earlier responses are only known to the caches
+		if v, ok := resolvePost(id.Sys.ID, index, cache); ok {
+			ref.Value, ref.Resolved = v, true
+			return ref
+		}
+		if v, ok := resolveAuthor(id.Sys.ID, index, cache); ok {
+			ref.Value, ref.Resolved = v, true
+			return ref
+		}
+		if v, ok := resolveCategory(id.Sys.ID, index, cache); ok {
+			ref.Value, ref.Resolved = v, true
+			return ref
+		}
+		return ref
+	}
+	switch entry.Sys.ContentType.Sys.ID {
+	case "2wKn6yEnZewu2SCCkus4as":
+		ref.Value, ref.Resolved = resolvePost(entry.Sys.ID, index, cache)
+	case "1kUEViTN4EmGiEaaeC6ouY":
+		ref.Value, ref.Resolved = resolveAuthor(entry.Sys.ID, index, cache)
+	case "5KMiN6YPvi42icqAUQMCQe":
+		ref.Value, ref.Resolved = resolveCategory(entry.Sys.ID, index, cache)
+	}
+	return ref
+}
+
+// maxIncludeDepth is the deepest link resolution contentful supports
+const maxIncludeDepth = 10
+
+// ErrResponseTooBig is returned when contentful rejects a response exceeding its size limit
+var ErrResponseTooBig = errors.New("response size too big")
+
+// ErrVersionMismatch is returned when a management api update is based on an outdated version of a resource
+var ErrVersionMismatch = errors.New("version mismatch")
+
+// responseError converts an unsuccessful response into an error
+func responseError(resp *http.Response) error {
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusBadRequest && bytes.Contains(body, []byte("Response size too big")) {
+		return ErrResponseTooBig
+	}
+	if resp.StatusCode == http.StatusConflict && bytes.Contains(body, []byte("VersionMismatch")) {
+		return ErrVersionMismatch
+	}
+	return fmt.Errorf("Request failed: %s, %s", resp.Status, body)
+}
+
+// NotFoundError is returned when a requested entry or asset does not exist. Field is set for lookups by a unique field other than the ID
+type NotFoundError struct {
+	ID          string
+	Field       string
+	ContentType string
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("%s %s of type %s not found", e.Field, e.ID, e.ContentType)
+	}
+	if e.ContentType == "" {
+		return fmt.Sprintf("%s not found", e.ID)
+	}
+	return fmt.Sprintf("%s of type %s not found", e.ID, e.ContentType)
+}
+
+// DuplicateError is returned by lookups by a unique field when more than one entry has the value. Type and Field are the generated Go names
+type DuplicateError struct {
+	Type  string
+	Field string
+	Value string
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("%s.%s %q is not unique", e.Type, e.Field, e.Value)
+}
+
+// Entry retrieves a single entry of any content type by its ID. The result is a pointer to the matching generated type
+func (c *ContentClient) Entry(id string) (Entry, error) {
+	query := url.QueryEscape(id)
+	chain := c.fallbackChain(c.Locale)
+	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&locale=%s&include=%d&limit=1&sys.id=%s", c.host, c.spaceID, c.authToken, localeQuery(chain), maxIncludeDepth, query)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data entriesResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
+	}
+	if len(data.Items) == 0 {
+		return nil, &NotFoundError{ID: id}
+	}
+	cache := c.newCache()
+	index := newLinkIndex(data.Items, data.Includes)
+	if c.fetchLinks {
+		if err := c.completeLinks(index, cache); err != nil {
+			return nil, err
+		}
+	}
+	switch data.Items[0].Sys.ContentType.Sys.ID {
+	case "2wKn6yEnZewu2SCCkus4as":
+		entry, _ := resolvePost(id, index, cache)
+		return &entry, nil
+	case "1kUEViTN4EmGiEaaeC6ouY":
+		entry, _ := resolveAuthor(id, index, cache)
+		return &entry, nil
+	case "5KMiN6YPvi42icqAUQMCQe":
+		entry, _ := resolveCategory(id, index, cache)
+		return &entry, nil
+	}
+	return nil, fmt.Errorf("unknown content type %s", data.Items[0].Sys.ContentType.Sys.ID)
+}
+
+// ContentClient implements a space specific contentful client
+type ContentClient struct {
+	host          string
+	spaceID       string
+	authToken     string
+	Locale        string
+	client        *http.Client
+	pool          *x509.CertPool
+	limiter       *rateLimiter
+	fetchLinks    bool
+	cache         *sharedCache
+	localesMu     sync.RWMutex
+	fallbacks     map[string]string
+	defaultLocale string
+}
+
+// rateLimiter spaces out requests to stay below a number of requests per second
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(perSecond int) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+	return &rateLimiter{interval: time.Second / time.Duration(perSecond)}
+}
+
+// wait blocks until the next request may be sent
+func (l *rateLimiter) wait() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.mu.Unlock()
+	time.Sleep(delay)
+}
+
+// SetRateLimit limits the client to perSecond requests. Zero or less disables rate limiting
+func (c *ContentClient) SetRateLimit(perSecond int) {
+	c.limiter = newRateLimiter(perSecond)
+}
+
+// maxRateLimitAttempts bounds the attempts of a request answered with 429 Too Many Requests
+const maxRateLimitAttempts = 3
+
+// get executes a rate limited GET request. Requests exceeding the api rate limit are retried after the delay requested by the api
+func (c *ContentClient) get(url string) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		c.limiter.wait()
+		resp, err := c.client.Get(url)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt >= maxRateLimitAttempts {
+			return resp, err
+		}
+		resp.Body.Close()
+		time.Sleep(retryAfter(resp))
+	}
+}
+
+// retryAfter returns the delay requested by a 429 response, defaulting to one second
+func retryAfter(resp *http.Response) time.Duration {
+	for _, header := range []string{"Retry-After", "X-Contentful-RateLimit-Reset"} {
+		if seconds, err := strconv.Atoi(resp.Header.Get(header)); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return time.Second
+}
+
+// contentfulCDAURL points to the contentful delivery api endpoint
+const contentfulCDAURL = "cdn.contentful.com"
+
+// contentfulCDAURL points to the contentful preview api endpoint
+const contentfulCPAURL = "preview.contentful.com"
+
+// contentfulCDAURL points to the contentful management api endpoint
+const contentfulCMAURL = "api.contentful.com"
+
+// contentfulUploadURL points to the contentful upload api endpoint
+const contentfulUploadURL = "upload.contentful.com"
+
+// cdaRateLimit is the default number of requests per second sent to the delivery api
+const cdaRateLimit = 55
+
+// cpaRateLimit is the default number of requests per second sent to the preview api
+const cpaRateLimit = 14
+
+// NewCDA returns a contentful client interfacing with the content delivery api
+func NewCDA(authToken string, locale string) *ContentClient {
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
+	return &ContentClient{
+		Locale:    locale,
+		authToken: authToken,
+		client:    &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
+		host:      fmt.Sprintf("https://%s", contentfulCDAURL),
+		limiter:   newRateLimiter(cdaRateLimit),
+		pool:      pool,
+		spaceID:   "ygx37epqlss8",
+	}
+}
+
+// NewCPA returns a contentful client interfacing with the content preview api
+func NewCPA(authToken string, locale string) *ContentClient {
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
+	return &ContentClient{
+		Locale:    locale,
+		authToken: authToken,
+		client:    &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
+		host:      fmt.Sprintf("https://%s", contentfulCPAURL),
+		limiter:   newRateLimiter(cpaRateLimit),
+		pool:      pool,
+		spaceID:   "ygx37epqlss8",
+	}
+}
+
+// maxLinkBatch is the number of IDs requested at once when fetching missing links
+const maxLinkBatch = 100
+
+// linkSet collects linked entry IDs grouped by content type and linked asset IDs
+type linkSet struct {
+	entries map[string][]string
+	assets  []string
+}
+
+func (s *linkSet) entry(contentType, id string) {
+	if id != "" {
+		s.entries[contentType] = append(s.entries[contentType], id)
+	}
+}
+func (s *linkSet) asset(id string) {
+	if id != "" {
+		s.assets = append(s.assets, id)
+	}
+}
+
+// entryLinks adds all links of a raw entry to the link set
+func entryLinks(entry *includeEntry, links *linkSet) error {
+	switch entry.Sys.ContentType.Sys.ID {
+	case "2wKn6yEnZewu2SCCkus4as":
+		var item postItem
+		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+			return err
+		}
+		for _, id := range item.Fields.Author {
+			links.entry("1kUEViTN4EmGiEaaeC6ouY", id.Sys.ID)
+		}
+		for _, id := range item.Fields.Category {
+			links.entry("5KMiN6YPvi42icqAUQMCQe", id.Sys.ID)
+		}
+		links.asset(item.Fields.FeaturedImage.Sys.ID)
+		links.entry("1kUEViTN4EmGiEaaeC6ouY", item.Fields.Approver.Sys.ID)
+		for _, id := range item.Fields.AuthorOrPost {
+			links.entry("", id.Sys.ID)
+		}
+	case "1kUEViTN4EmGiEaaeC6ouY":
+		var item authorItem
+		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+			return err
+		}
+		links.asset(item.Fields.ProfilePhoto.Sys.ID)
+		for _, id := range item.Fields.CreatedEntries {
+			links.entry("2wKn6yEnZewu2SCCkus4as", id.Sys.ID)
+		}
+	case "5KMiN6YPvi42icqAUQMCQe":
+		var item categoryItem
+		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+			return err
+		}
+		links.asset(item.Fields.Icon.Sys.ID)
+		links.entry("5KMiN6YPvi42icqAUQMCQe", item.Fields.Parent.Sys.ID)
+	}
+	return nil
+}
+
+// SetFetchMissingLinks enables fetching links which are not part of a response, e.g. beyond the include depth, with additional requests
+func (c *ContentClient) SetFetchMissingLinks(enabled bool) {
+	c.fetchLinks = enabled
+}
+
+// completeLinks fetches linked entries and assets missing from the index until every link of the index can be resolved. Fetched links are kept in the cache for later responses
+func (c *ContentClient) completeLinks(index *linkIndex, cache *iteratorCache) error {
+	var pending []*includeEntry
+	for _, entry := range index.entries {
+		pending = append(pending, entry)
+	}
+	requested := map[string]bool{}
+	for len(pending) > 0 {
+		links := &linkSet{entries: map[string][]string{}}
+		for _, entry := range pending {
+			if err := entryLinks(entry, links); err != nil {
+				return err
+			}
+		}
+		pending = nil
+		for contentType, ids := range links.entries {
+			var missing []string
+			for _, id := range ids {
+				if _, ok := index.entries[id]; ok || requested[id] || cache.has(id) {
+					continue
+				}
+				requested[id] = true
+				if entry, ok := cache.fetched.entries[id]; ok {
+					index.entries[id] = entry
+					pending = append(pending, entry)
+					continue
+				}
+				missing = append(missing, id)
+			}
+			for start := 0; start < len(missing); start += maxLinkBatch {
+				items, err := c.requestLinkedEntries(cache.locale, contentType, missing[start:min(start+maxLinkBatch, len(missing))])
+				if err != nil {
+					return err
+				}
+				for i := range items {
+					entry := &items[i]
+					index.entries[entry.Sys.ID] = entry
+					cache.fetched.entries[entry.Sys.ID] = entry
+					pending = append(pending, entry)
+				}
+			}
+		}
+		var missing []string
+		for _, id := range links.assets {
+			if _, ok := index.assets[id]; ok || requested[id] {
+				continue
+			}
+			if _, ok := cache.shared.get("Asset:" + id); ok {
+				continue
+			}
+			requested[id] = true
+			if asset, ok := cache.fetched.assets[id]; ok {
+				index.assets[id] = asset
+				continue
+			}
+			missing = append(missing, id)
+		}
+		for start := 0; start < len(missing); start += maxLinkBatch {
+			assets, err := c.requestLinkedAssets(cache.locale, missing[start:min(start+maxLinkBatch, len(missing))])
+			if err != nil {
+				return err
+			}
+			for i := range assets {
+				asset := &assets[i]
+				index.assets[asset.Sys.ID] = asset
+				cache.fetched.assets[asset.Sys.ID] = asset
+			}
+		}
+	}
+	return nil
+}
+
+// requestLinkedEntries requests entries by ID, restricted to a content type unless it is empty
+func (c *ContentClient) requestLinkedEntries(locale string, contentType string, ids []string) ([]includeEntry, error) {
+	chain := c.fallbackChain(locale)
+	query := url.QueryEscape(strings.Join(ids, ","))
+	var url = fmt.Sprintf("%s/spaces/%s/entries?access_token=%s&locale=%s&include=0&limit=%d&sys.id[in]=%s", c.host, c.spaceID, c.authToken, localeQuery(chain), len(ids), query)
+	if contentType != "" {
+		url += "&content_type=" + contentType
+	}
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data entriesResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
+	}
+	return data.Items, nil
+}
+
+// requestLinkedAssets requests assets by ID
+func (c *ContentClient) requestLinkedAssets(locale string, ids []string) ([]includeAsset, error) {
+	chain := c.fallbackChain(locale)
+	query := url.QueryEscape(strings.Join(ids, ","))
+	var url = fmt.Sprintf("%s/spaces/%s/assets?access_token=%s&locale=%s&limit=%d&sys.id[in]=%s", c.host, c.spaceID, c.authToken, localeQuery(chain), len(ids), query)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data assetsResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return nil, err
+	}
+	return data.Items, nil
+}
+
+// Cache stores entries and assets across requests of a client. Keys are prefixed with the link type, e.g. Entry:<id> or Asset:<id>. Implementations must be safe for concurrent use.
+// Values are the raw entries and assets of the delivery api encoded as JSON []byte, so any backing store can hold them. Get may return them as []byte or string. Entries are resolved again on every lookup
+type Cache interface {
+	Get(key string) (interface{}, bool)
+	Set(key string, value interface{})
+	Delete(key string)
+}
+
+// lruCache is a size bounded Cache evicting the least recently used values and values older than its ttl
+type lruCache struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	items   map[string]*list.Element
+	order   *list.List
+	onEvict func(key string)
+}
+type lruItem struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// NewLRUCache returns a Cache holding up to size values for at most ttl. Zero or less disables the respective limit
+func NewLRUCache(size int, ttl time.Duration) Cache {
+	return &lruCache{
+		items: map[string]*list.Element{},
+		order: list.New(),
+		size:  size,
+		ttl:   ttl,
+	}
+}
+func (c *lruCache) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	el, ok := c.items[key]
+	if !ok {
+		c.mu.Unlock()
+		return nil, false
+	}
+	item := el.Value.(*lruItem)
+	if c.expired(item) {
+		c.remove(el)
+		c.mu.Unlock()
+		c.notify([]string{key})
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	c.mu.Unlock()
+	return item.value, true
+}
+func (c *lruCache) Set(key string, value interface{}) {
+	c.mu.Lock()
+	item := &lruItem{
+		expires: time.Now().Add(c.ttl),
+		key:     key,
+		value:   value,
+	}
+	if el, ok := c.items[key]; ok {
+		el.Value = item
+		c.order.MoveToFront(el)
+		c.mu.Unlock()
+		return
+	}
+	c.items[key] = c.order.PushFront(item)
+	var evicted []string
+	// drop expired values which were not requested since, the back of the list holds the least recently used values
+	for oldest := c.order.Back(); oldest != nil; oldest = c.order.Back() {
+		old := oldest.Value.(*lruItem)
+		if !c.expired(old) && (c.size <= 0 || c.order.Len() <= c.size) {
+			break
+		}
+		c.remove(oldest)
+		evicted = append(evicted, old.key)
+	}
+	c.mu.Unlock()
+	c.notify(evicted)
+}
+func (c *lruCache) expired(item *lruItem) bool {
+	return c.ttl > 0 && time.Now().After(item.expires)
+}
+
+// remove drops an element. The caller must hold the lock
+func (c *lruCache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*lruItem).key)
+}
+
+// notify reports values dropped because of the size limit or their ttl. It must be called without holding the lock
+func (c *lruCache) notify(keys []string) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, key := range keys {
+		c.onEvict(key)
+	}
+}
+
+// setOnEvict registers fn to be called for every value dropped because of the size limit or its ttl
+func (c *lruCache) setOnEvict(fn func(key string)) {
+	c.mu.Lock()
+	c.onEvict = fn
+	c.mu.Unlock()
+}
+func (c *lruCache) Delete(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// evictionNotifier is implemented by caches reporting values they drop on their own
+type evictionNotifier interface {
+	setOnEvict(fn func(key string))
+}
+
+// sharedCache wraps the client cache and tracks which cached entries link to other entries and assets, so changes can be evicted along with everything resolving through them.
+// links holds the targets of every tracked entry and asset, typeOf the content type of every tracked entry, to remove them from the reverse indexes once they leave the cache
+type sharedCache struct {
+	cache    Cache
+	mu       sync.Mutex
+	linkedBy map[string]map[string]bool
+	types    map[string]map[string]bool
+	links    map[string][]string
+	typeOf   map[string]string
+}
+
+// newSharedCache wraps cache, evicting the entries linking to values cache drops on its own
+func newSharedCache(cache Cache) *sharedCache {
+	s := &sharedCache{
+		cache:    cache,
+		linkedBy: map[string]map[string]bool{},
+		links:    map[string][]string{},
+		typeOf:   map[string]string{},
+		types:    map[string]map[string]bool{},
+	}
+	if n, ok := cache.(evictionNotifier); ok {
+		n.setOnEvict(s.forget)
+	}
+	return s
+}
+
+// forget handles a key which left the cache. Entries linking to it would resolve without it, so they are evicted as well
+func (s *sharedCache) forget(key string) {
+	s.evict(key)
+}
+
+// untrack removes the links and the content type of key from the reverse indexes. The caller must hold the lock
+func (s *sharedCache) untrack(key string) {
+	for _, target := range s.links[key] {
+		delete(s.linkedBy[target], key)
+		if len(s.linkedBy[target]) == 0 {
+			delete(s.linkedBy, target)
+		}
+	}
+	delete(s.links, key)
+	if contentType, ok := s.typeOf[key]; ok {
+		delete(s.types[contentType], key)
+		if len(s.types[contentType]) == 0 {
+			delete(s.types, contentType)
+		}
+		delete(s.typeOf, key)
+	}
+}
+func (s *sharedCache) get(key string) (interface{}, bool) {
+	if s == nil {
+		return nil, false
+	}
+	return s.cache.Get(key)
+}
+
+// entry returns the cached raw entry with the given ID
+func (s *sharedCache) entry(id string) (*includeEntry, bool) {
+	var entry includeEntry
+	if !s.decode("Entry:"+id, &entry) {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// asset returns the cached raw asset with the given ID
+func (s *sharedCache) asset(id string) (*includeAsset, bool) {
+	var asset includeAsset
+	if !s.decode("Asset:"+id, &asset) {
+		return nil, false
+	}
+	return &asset, true
+}
+
+// decode unmarshals the value cached for key into v
+func (s *sharedCache) decode(key string, v interface{}) bool {
+	value, ok := s.get(key)
+	if !ok {
+		return false
+	}
+	var raw []byte
+	switch value := value.(type) {
+	case []byte:
+		raw = value
+	case string:
+		raw = []byte(value)
+	default:
+		return false
+	}
+	return json.Unmarshal(raw, v) == nil
+}
+
+// setEntry caches a raw entry and records its links
+func (s *sharedCache) setEntry(entry *includeEntry) {
+	if s == nil {
+		return
+	}
+	key := "Entry:" + entry.Sys.ID
+	links := &linkSet{entries: map[string][]string{}}
+	if err := entryLinks(entry, links); err != nil {
+		return
+	}
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	var targets []string
+	for _, ids := range links.entries {
+		for _, id := range ids {
+			targets = append(targets, "Entry:"+id)
+		}
+	}
+	for _, id := range links.assets {
+		targets = append(targets, "Asset:"+id)
+	}
+	add := func(m map[string]map[string]bool, target, key string) {
+		if m[target] == nil {
+			m[target] = map[string]bool{}
+		}
+		m[target][key] = true
+	}
+	s.mu.Lock()
+	s.untrack(key)
+	for _, target := range targets {
+		add(s.linkedBy, target, key)
+	}
+	s.links[key] = targets
+	add(s.types, entry.Sys.ContentType.Sys.ID, key)
+	s.typeOf[key] = entry.Sys.ContentType.Sys.ID
+	s.mu.Unlock()
+	s.store(key, value)
+}
+
+// setAsset caches a raw asset
+func (s *sharedCache) setAsset(asset *includeAsset) {
+	if s == nil {
+		return
+	}
+	key := "Asset:" + asset.Sys.ID
+	value, err := json.Marshal(asset)
+	if err != nil {
+		return
+	}
+	s.mu.Lock()
+	s.links[key] = nil
+	s.mu.Unlock()
+	s.store(key, value)
+}
+
+// store sets the value of a tracked key. The cache may report evictions from within Set, so it is called without holding the lock.
+// A concurrent evict between tracking key and storing its value would leave the value cached but untracked, out of reach of later invalidations, so such values are deleted again
+func (s *sharedCache) store(key string, value []byte) {
+	s.cache.Set(key, value)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.links[key]; !ok {
+		s.cache.Delete(key)
+	}
+}
+
+// evict removes the given keys and, transitively, all cached entries linking to them
+func (s *sharedCache) evict(keys ...string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for len(keys) > 0 {
+		key := keys[len(keys)-1]
+		keys = keys[:len(keys)-1]
+		s.cache.Delete(key)
+		s.untrack(key)
+		for linker := range s.linkedBy[key] {
+			keys = append(keys, linker)
+		}
+		delete(s.linkedBy, key)
+	}
+}
+
+// evictContentType removes all cached entries of a content type and the entries linking to them
+func (s *sharedCache) evictContentType(contentType string) {
+	if s == nil {
+		return
+	}
+	var keys []string
+	s.mu.Lock()
+	for key := range s.types[contentType] {
+		keys = append(keys, key)
+	}
+	delete(s.types, contentType)
+	s.mu.Unlock()
+	s.evict(keys...)
+}
+
+// SetCache shares entries and assets between all requests of the client. A nil cache disables sharing
+func (c *ContentClient) SetCache(cache Cache) {
+	if cache == nil {
+		c.cache = nil
+		return
+	}
+	c.cache = newSharedCache(cache)
+}
+
+// newCache returns an iterator cache for the client locale backed by the client cache
+func (c *ContentClient) newCache() *iteratorCache {
+	return c.newLocaleCache(c.Locale)
+}
+
+// webhookSignatureTTL is the maximum age of a signed webhook request
+const webhookSignatureTTL = 30 * time.Second
+
+// WebhookHandler evicts entries and assets changed in contentful, as well as all cached entries linking to them, from the client cache.
+// All requests are rejected unless Secret or the basic auth credentials are set
+type WebhookHandler struct {
+	// Secret is the signing secret of the webhook. Requests without a valid signature are rejected if set
+	Secret string
+	// Username and Password are the expected basic auth credentials if set
+	Username string
+	Password string
+	c        *ContentClient
+}
+
+// WebhookHandler returns a handler for Entry, Asset and ContentType webhook calls invalidating the client cache
+func (c *ContentClient) WebhookHandler() *WebhookHandler {
+	return &WebhookHandler{c: c}
+}
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !h.verify(r, body) {
+		http.Error(w, "invalid webhook request", http.StatusUnauthorized)
+		return
+	}
+	var payload struct {
+		Sys sys `json:"sys"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	// topics look like ContentManagement.Entry.publish
+	topic := strings.Split(r.Header.Get("X-Contentful-Topic"), ".")
+	if len(topic) != 3 {
+		http.Error(w, "unknown webhook topic", http.StatusBadRequest)
+		return
+	}
+	switch topic[1] {
+	case "Entry":
+		h.c.cache.evict("Entry:" + payload.Sys.ID)
+	case "Asset":
+		h.c.cache.evict("Asset:" + payload.Sys.ID)
+	case "ContentType":
+		h.c.cache.evictContentType(payload.Sys.ID)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// verify checks the basic auth credentials and the request signature, if configured. Requests to a handler without either are rejected
+func (h *WebhookHandler) verify(r *http.Request, body []byte) bool {
+	basicAuth := h.Username != "" || h.Password != ""
+	if !basicAuth && h.Secret == "" {
+		return false
+	}
+	if basicAuth {
+		username, password, ok := r.BasicAuth()
+		if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(h.Username)) != 1 || subtle.ConstantTimeCompare([]byte(password), []byte(h.Password)) != 1 {
+			return false
+		}
+	}
+	if h.Secret == "" {
+		return true
+	}
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-Contentful-Timestamp"), 10, 64)
+	if err != nil {
+		return false
+	}
+	age := time.Since(time.UnixMilli(timestamp))
+	if age > webhookSignatureTTL || age < -webhookSignatureTTL {
+		return false
+	}
+	// the canonical request is the method, path, signed headers and body separated by newlines
+	var headers []string
+	for _, name := range strings.Split(r.Header.Get("X-Contentful-Signed-Headers"), ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		value := r.Header.Get(name)
+		if name == "host" {
+			value = r.Host
+		}
+		headers = append(headers, name+":"+value)
+	}
+	canonical := strings.Join([]string{r.Method, r.URL.RequestURI(), strings.Join(headers, ";"), string(body)}, "\n")
+	mac := hmac.New(sha256.New, []byte(h.Secret))
+	mac.Write([]byte(canonical))
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(r.Header.Get("X-Contentful-Signature")))
+}
+
+// Locale describes a locale of the space. FallbackCode names the locale used for fields without a value, if any
+type Locale struct {
+	Code         string `json:"code"`
+	Name         string `json:"name"`
+	Default      bool   `json:"default"`
+	FallbackCode string `json:"fallbackCode"`
+}
+
+// Locales retrieves the locales of the space. The client remembers their fallback chains and fills empty fields of later responses along them
+func (c *ContentClient) Locales() ([]Locale, error) {
+	var url = fmt.Sprintf("%s/spaces/%s/locales?access_token=%s", c.host, c.spaceID, c.authToken)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data struct {
+		Items []Locale `json:"items"`
+	}
+	if err := decodeResponse(resp, nil, &data); err != nil {
+		return nil, err
+	}
+	fallbacks := make(map[string]string, len(data.Items))
+	var defaultLocale string
+	for _, locale := range data.Items {
+		fallbacks[locale.Code] = locale.FallbackCode
+		if locale.Default {
+			defaultLocale = locale.Code
+		}
+	}
+	c.localesMu.Lock()
+	c.fallbacks = fallbacks
+	c.defaultLocale = defaultLocale
+	c.localesMu.Unlock()
+	return data.Items, nil
+}
+
+// fallbackChain returns locale followed by its fallback locales and the default locale, as far as known from Locales.
+// The default locale ends every chain because multi locale responses hold fields which are not localized only in the default locale
+func (c *ContentClient) fallbackChain(locale string) []string {
+	if locale == "*" {
+		// all locales are requested as they are
+		return []string{locale}
+	}
+	c.localesMu.RLock()
+	defer c.localesMu.RUnlock()
+	chain := []string{locale}
+	seen := map[string]bool{locale: true}
+	for next := c.fallbacks[locale]; next != "" && !seen[next]; next = c.fallbacks[next] {
+		seen[next] = true
+		chain = append(chain, next)
+	}
+	if c.defaultLocale != "" && !seen[c.defaultLocale] {
+		chain = append(chain, c.defaultLocale)
+	}
+	return chain
+}
+
+// localeQuery returns the locale parameter for a fallback chain. Chains of several locales request all locales to fill empty fields locally
+func localeQuery(chain []string) string {
+	if len(chain) > 1 {
+		return "*"
+	}
+	return chain[0]
+}
+
+// localizedFields picks the values of the first locale of chain that has them from the fields of a multi locale entry or asset
+func localizedFields(fields json.RawMessage, chain []string) (*json.RawMessage, error) {
+	var all map[string]map[string]json.RawMessage
+	if err := json.Unmarshal(fields, &all); err != nil {
+		return nil, err
+	}
+	flat := make(map[string]json.RawMessage, len(all))
+	for name, values := range all {
+		for _, locale := range chain {
+			if v, ok := values[locale]; ok && string(v) != "null" {
+				flat[name] = v
+				break
+			}
+		}
+	}
+	b, err := json.Marshal(flat)
+	if err != nil {
+		return nil, err
+	}
+	raw := json.RawMessage(b)
+	return &raw, nil
+}
+
+// localizeItems localizes the fields of a list of multi locale entries or assets
+func localizeItems(raw json.RawMessage, chain []string) (json.RawMessage, error) {
+	var items []map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if fields, ok := item["fields"]; ok {
+			localized, err := localizedFields(fields, chain)
+			if err != nil {
+				return nil, err
+			}
+			item["fields"] = *localized
+		}
+	}
+	return json.Marshal(items)
+}
+
+// localizeResponse rewrites a multi locale response of a single item or a list with includes into a single locale response along chain
+func localizeResponse(body []byte, chain []string) ([]byte, error) {
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal(body, &data); err != nil {
+		return nil, err
+	}
+	if fields, ok := data["fields"]; ok {
+		localized, err := localizedFields(fields, chain)
+		if err != nil {
+			return nil, err
+		}
+		data["fields"] = *localized
+	}
+	if items, ok := data["items"]; ok {
+		localized, err := localizeItems(items, chain)
+		if err != nil {
+			return nil, err
+		}
+		data["items"] = localized
+	}
+	if raw, ok := data["includes"]; ok {
+		var includes map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &includes); err != nil {
+			return nil, err
+		}
+		for kind, items := range includes {
+			localized, err := localizeItems(items, chain)
+			if err != nil {
+				return nil, err
+			}
+			includes[kind] = localized
+		}
+		localized, err := json.Marshal(includes)
+		if err != nil {
+			return nil, err
+		}
+		data["includes"] = localized
+	}
+	return json.Marshal(data)
+}
+
+// decodeResponse reads and closes a response body and decodes it into v. Responses requested for a chain of several locales are localized first
+func decodeResponse(resp *http.Response, chain []string, v any) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return err
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if len(chain) > 1 {
+		if body, err = localizeResponse(body, chain); err != nil {
+			return err
+		}
+	}
+	return json.Unmarshal(body, v)
+}
+
+// newLocaleCache returns an iterator cache for responses in the given locale. Only caches for the client locale are backed by the client cache
+func (c *ContentClient) newLocaleCache(locale string) *iteratorCache {
+	cache := newIteratorCache()
+	cache.locale = locale
+	if locale == c.Locale {
+		cache.shared = c.cache
+	}
+	return cache
+}
+
+// Localized holds the values of a localized field per locale code
+type Localized[T any] struct {
+	Values    map[string]T
+	fallbacks map[string]string
+}
+
+// Get returns the value of a locale. Locales without a value fall back along the fallback chain known from Locales
+func (l Localized[T]) Get(locale string) (T, bool) {
+	seen := map[string]bool{}
+	for locale != "" && !seen[locale] {
+		if v, ok := l.Values[locale]; ok {
+			return v, true
+		}
+		seen[locale] = true
+		locale = l.fallbacks[locale]
+	}
+	var zero T
+	return zero, false
+}
+
+// localeFallbacks returns the fallback locale of every locale known from Locales
+func (c *ContentClient) localeFallbacks() map[string]string {
+	c.localesMu.RLock()
+	defer c.localesMu.RUnlock()
+	return c.fallbacks
+}
+
+// identity converts field values which are used as decoded
+func identity[T any](v T) T {
+	return v
+}
+
+// linkID returns the ID of a linked entry or asset
+func linkID(id entryID) string {
+	return id.Sys.ID
+}
+
+// linkIDs returns the IDs of linked entries or assets
+func linkIDs(ids entryIDs) []string {
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = id.Sys.ID
+	}
+	return values
+}
+
+// localizedValue decodes the values of a localized field of a multi locale entry
+func localizedValue[T, U any](values map[string]json.RawMessage, fallbacks map[string]string, convert func(T) U) (Localized[U], error) {
+	l := Localized[U]{
+		Values:    make(map[string]U, len(values)),
+		fallbacks: fallbacks,
+	}
+	for locale, raw := range values {
+		if string(raw) == "null" {
+			continue
+		}
+		var v T
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return l, err
+		}
+		l.Values[locale] = convert(v)
+	}
+	return l, nil
+}
+
+// unlocalizedValue decodes the single value of a field of a multi locale entry which is not localized
+func unlocalizedValue[T, U any](values map[string]json.RawMessage, convert func(T) U) (U, error) {
+	var v T
+	for _, raw := range values {
+		if err := json.Unmarshal(raw, &v); err != nil {
+			var zero U
+			return zero, err
+		}
+		break
+	}
+	return convert(v), nil
+}
+
+// LocalizedPost holds the values of all locales of a Post. Links are represented by the IDs of the linked entries and assets
+type LocalizedPost struct {
+	ID            string
+	Title         Localized[string]
+	Slug          string
+	Author        []string
+	Body          Localized[string]
+	Category      []string
+	Tags          []string
+	FeaturedImage string
+	Date          Date
+	Comments      bool
+	Approver      string
+	AuthorOrPost  []string
+}
+
+// decodeLocalizedPost converts a raw multi locale Post entry
+func decodeLocalizedPost(raw includeEntry, fallbacks map[string]string) (*LocalizedPost, error) {
+	var fields map[string]map[string]json.RawMessage
+	if raw.Fields != nil {
+		if err := json.Unmarshal(*raw.Fields, &fields); err != nil {
+			return nil, err
+		}
+	}
+	item := &LocalizedPost{ID: raw.Sys.ID}
+	var err error
+	if item.Title, err = localizedValue(fields["title"], fallbacks, identity[string]); err != nil {
+		return nil, err
+	}
+	if item.Slug, err = unlocalizedValue(fields["slug"], identity[string]); err != nil {
+		return nil, err
+	}
+	if item.Author, err = unlocalizedValue(fields["author"], linkIDs); err != nil {
+		return nil, err
+	}
+	if item.Body, err = localizedValue(fields["body"], fallbacks, identity[string]); err != nil {
+		return nil, err
+	}
+	if item.Category, err = unlocalizedValue(fields["category"], linkIDs); err != nil {
+		return nil, err
+	}
+	if item.Tags, err = unlocalizedValue(fields["tags"], identity[[]string]); err != nil {
+		return nil, err
+	}
+	if item.FeaturedImage, err = unlocalizedValue(fields["featuredImage"], linkID); err != nil {
+		return nil, err
+	}
+	if item.Date, err = unlocalizedValue(fields["date"], identity[Date]); err != nil {
+		return nil, err
+	}
+	if item.Comments, err = unlocalizedValue(fields["comments"], identity[bool]); err != nil {
+		return nil, err
+	}
+	if item.Approver, err = unlocalizedValue(fields["approver"], linkID); err != nil {
+		return nil, err
+	}
+	if item.AuthorOrPost, err = unlocalizedValue(fields["authorOrPost"], linkIDs); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// LocalizedPostIterator is used to paginate result sets of LocalizedPost
+type LocalizedPostIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ContentClient
+	items   []*LocalizedPost
+	query   string
+}
+
+// setPage records a fetched page in the pagination state
+func (it *LocalizedPostIterator) setPage(items []*LocalizedPost, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type LocalizedPost. If none exists a network request will be executed
+func (it *LocalizedPostIterator) Next() (*LocalizedPost, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *LocalizedPost
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of LocalizedPost. If none exists a network request will be executed
+func (it *LocalizedPostIterator) Page() ([]*LocalizedPost, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining LocalizedPost items. Iteration stops after the first error
+func (it *LocalizedPostIterator) All() iter.Seq2[*LocalizedPost, error] {
+	return func(yield func(*LocalizedPost, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining LocalizedPost items. A max of zero or less collects all items
+func (it *LocalizedPostIterator) Collect(max int) ([]*LocalizedPost, error) {
+	var items []*LocalizedPost
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining LocalizedPost item and stops at the first error
+func (it *LocalizedPostIterator) ForEach(fn func(*LocalizedPost) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *LocalizedPostIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type LocalizedPost
+func (it *LocalizedPostIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *LocalizedPostIterator) fetch() error {
+	data, err := it.c.requestPosts("*", fmt.Sprintf("include=0&limit=%d&skip=%d&%s", it.Limit, it.Offset, it.query))
+	if err != nil {
+		return err
+	}
+	fallbacks := it.c.localeFallbacks()
+	items := make([]*LocalizedPost, len(data.Items))
+	for i, raw := range data.Items {
+		if items[i], err = decodeLocalizedPost(raw, fallbacks); err != nil {
+			return err
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// LocalizedPosts retrieves paginated Post entries with the values of all locales. Call Locales first for Get to apply fallbacks
+func (c *ContentClient) LocalizedPosts(opts ListOptions) *LocalizedPostIterator {
+	return c.QueryLocalizedPosts(PostQuery(), opts)
+}
+
+// QueryLocalizedPosts retrieves paginated Post entries matching the given query with the values of all locales
+func (c *ContentClient) QueryLocalizedPosts(q *PostQueryBuilder, opts ListOptions) *LocalizedPostIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &LocalizedPostIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      c,
+		query:  q.encode(),
+	}
+}
+
+// LocalizedAuthor holds the values of all locales of a Author. Links are represented by the IDs of the linked entries and assets
+type LocalizedAuthor struct {
+	ID             string
+	Name           string
+	Website        string
+	ProfilePhoto   string
+	Biography      Localized[string]
+	CreatedEntries []string
+	Age            int64
+	Rating         float64
+}
+
+// decodeLocalizedAuthor converts a raw multi locale Author entry
+func decodeLocalizedAuthor(raw includeEntry, fallbacks map[string]string) (*LocalizedAuthor, error) {
+	var fields map[string]map[string]json.RawMessage
+	if raw.Fields != nil {
+		if err := json.Unmarshal(*raw.Fields, &fields); err != nil {
+			return nil, err
+		}
+	}
+	item := &LocalizedAuthor{ID: raw.Sys.ID}
+	var err error
+	if item.Name, err = unlocalizedValue(fields["name"], identity[string]); err != nil {
+		return nil, err
+	}
+	if item.Website, err = unlocalizedValue(fields["website"], identity[string]); err != nil {
+		return nil, err
+	}
+	if item.ProfilePhoto, err = unlocalizedValue(fields["profilePhoto"], linkID); err != nil {
+		return nil, err
+	}
+	if item.Biography, err = localizedValue(fields["biography"], fallbacks, identity[string]); err != nil {
+		return nil, err
+	}
+	if item.CreatedEntries, err = unlocalizedValue(fields["createdEntries"], linkIDs); err != nil {
+		return nil, err
+	}
+	if item.Age, err = unlocalizedValue(fields["age"], identity[int64]); err != nil {
+		return nil, err
+	}
+	if item.Rating, err = unlocalizedValue(fields["rating"], identity[float64]); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// LocalizedAuthorIterator is used to paginate result sets of LocalizedAuthor
+type LocalizedAuthorIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ContentClient
+	items   []*LocalizedAuthor
+	query   string
+}
+
+// setPage records a fetched page in the pagination state
+func (it *LocalizedAuthorIterator) setPage(items []*LocalizedAuthor, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type LocalizedAuthor. If none exists a network request will be executed
+func (it *LocalizedAuthorIterator) Next() (*LocalizedAuthor, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *LocalizedAuthor
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of LocalizedAuthor. If none exists a network request will be executed
+func (it *LocalizedAuthorIterator) Page() ([]*LocalizedAuthor, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining LocalizedAuthor items. Iteration stops after the first error
+func (it *LocalizedAuthorIterator) All() iter.Seq2[*LocalizedAuthor, error] {
+	return func(yield func(*LocalizedAuthor, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining LocalizedAuthor items. A max of zero or less collects all items
+func (it *LocalizedAuthorIterator) Collect(max int) ([]*LocalizedAuthor, error) {
+	var items []*LocalizedAuthor
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining LocalizedAuthor item and stops at the first error
+func (it *LocalizedAuthorIterator) ForEach(fn func(*LocalizedAuthor) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *LocalizedAuthorIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type LocalizedAuthor
+func (it *LocalizedAuthorIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *LocalizedAuthorIterator) fetch() error {
+	data, err := it.c.requestAuthors("*", fmt.Sprintf("include=0&limit=%d&skip=%d&%s", it.Limit, it.Offset, it.query))
+	if err != nil {
+		return err
+	}
+	fallbacks := it.c.localeFallbacks()
+	items := make([]*LocalizedAuthor, len(data.Items))
+	for i, raw := range data.Items {
+		if items[i], err = decodeLocalizedAuthor(raw, fallbacks); err != nil {
+			return err
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// LocalizedAuthors retrieves paginated Author entries with the values of all locales. Call Locales first for Get to apply fallbacks
+func (c *ContentClient) LocalizedAuthors(opts ListOptions) *LocalizedAuthorIterator {
+	return c.QueryLocalizedAuthors(AuthorQuery(), opts)
+}
+
+// QueryLocalizedAuthors retrieves paginated Author entries matching the given query with the values of all locales
+func (c *ContentClient) QueryLocalizedAuthors(q *AuthorQueryBuilder, opts ListOptions) *LocalizedAuthorIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &LocalizedAuthorIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      c,
+		query:  q.encode(),
+	}
+}
+
+// LocalizedCategory holds the values of all locales of a Category. Links are represented by the IDs of the linked entries and assets
+type LocalizedCategory struct {
+	ID               string
+	Title            Localized[string]
+	ShortDescription Localized[string]
+	Icon             string
+	Parent           string
+}
+
+// decodeLocalizedCategory converts a raw multi locale Category entry
+func decodeLocalizedCategory(raw includeEntry, fallbacks map[string]string) (*LocalizedCategory, error) {
+	var fields map[string]map[string]json.RawMessage
+	if raw.Fields != nil {
+		if err := json.Unmarshal(*raw.Fields, &fields); err != nil {
+			return nil, err
+		}
+	}
+	item := &LocalizedCategory{ID: raw.Sys.ID}
+	var err error
+	if item.Title, err = localizedValue(fields["title"], fallbacks, identity[string]); err != nil {
+		return nil, err
+	}
+	if item.ShortDescription, err = localizedValue(fields["shortDescription"], fallbacks, identity[string]); err != nil {
+		return nil, err
+	}
+	if item.Icon, err = unlocalizedValue(fields["icon"], linkID); err != nil {
+		return nil, err
+	}
+	if item.Parent, err = unlocalizedValue(fields["parent"], linkID); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// LocalizedCategoryIterator is used to paginate result sets of LocalizedCategory
+type LocalizedCategoryIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ContentClient
+	items   []*LocalizedCategory
+	query   string
+}
+
+// setPage records a fetched page in the pagination state
+func (it *LocalizedCategoryIterator) setPage(items []*LocalizedCategory, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type LocalizedCategory. If none exists a network request will be executed
+func (it *LocalizedCategoryIterator) Next() (*LocalizedCategory, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *LocalizedCategory
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of LocalizedCategory. If none exists a network request will be executed
+func (it *LocalizedCategoryIterator) Page() ([]*LocalizedCategory, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining LocalizedCategory items. Iteration stops after the first error
+func (it *LocalizedCategoryIterator) All() iter.Seq2[*LocalizedCategory, error] {
+	return func(yield func(*LocalizedCategory, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining LocalizedCategory items. A max of zero or less collects all items
+func (it *LocalizedCategoryIterator) Collect(max int) ([]*LocalizedCategory, error) {
+	var items []*LocalizedCategory
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining LocalizedCategory item and stops at the first error
+func (it *LocalizedCategoryIterator) ForEach(fn func(*LocalizedCategory) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *LocalizedCategoryIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type LocalizedCategory
+func (it *LocalizedCategoryIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *LocalizedCategoryIterator) fetch() error {
+	data, err := it.c.requestCategories("*", fmt.Sprintf("include=0&limit=%d&skip=%d&%s", it.Limit, it.Offset, it.query))
+	if err != nil {
+		return err
+	}
+	fallbacks := it.c.localeFallbacks()
+	items := make([]*LocalizedCategory, len(data.Items))
+	for i, raw := range data.Items {
+		if items[i], err = decodeLocalizedCategory(raw, fallbacks); err != nil {
+			return err
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// LocalizedCategories retrieves paginated Category entries with the values of all locales. Call Locales first for Get to apply fallbacks
+func (c *ContentClient) LocalizedCategories(opts ListOptions) *LocalizedCategoryIterator {
+	return c.QueryLocalizedCategories(CategoryQuery(), opts)
+}
+
+// QueryLocalizedCategories retrieves paginated Category entries matching the given query with the values of all locales
+func (c *ContentClient) QueryLocalizedCategories(q *CategoryQueryBuilder, opts ListOptions) *LocalizedCategoryIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &LocalizedCategoryIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      c,
+		query:  q.encode(),
+	}
+}
+
+// SyncResult holds the changes of a space since the previous synchronization
+type SyncResult struct {
+	Posts          []*Post
+	Authors        []*Author
+	Categories     []*Category
+	Assets         []*Asset
+	DeletedEntries []string
+	DeletedAssets  []string
+	// Token is passed to the next Sync call to only receive later changes
+	Token string
+}
+
+// syncItem holds a single multi locale entry, asset or deletion of a sync response
+type syncItem struct {
+	Sys    sys             `json:"sys"`
+	Fields json.RawMessage `json:"fields"`
+}
+
+// syncResponse holds a single page of a sync response
+type syncResponse struct {
+	Items       []syncItem `json:"items"`
+	NextPageURL string     `json:"nextPageUrl"`
+	NextSyncURL string     `json:"nextSyncUrl"`
+}
+
+// syncToken extracts the sync token of a next page or next sync url
+func syncToken(next string) (string, error) {
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", err
+	}
+	return u.Query().Get("sync_token"), nil
+}
+
+// requestSync requests a single page of the sync api
+func (c *ContentClient) requestSync(params string) (*syncResponse, error) {
+	var url = fmt.Sprintf("%s/spaces/%s/sync?access_token=%s&%s", c.host, c.spaceID, c.authToken, params)
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var data syncResponse
+	// sync pages hold all locales, they are localized by the caller
+	if err := decodeResponse(resp, nil, &data); err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// syncPages requests all pages of a synchronization, returning the entries and assets in the client locale, the deletions and the next sync token. The locales of the space are retrieved first if unknown
+func (c *ContentClient) syncPages(token string) (entries []includeEntry, assets []includeAsset, result *SyncResult, err error) {
+	result = &SyncResult{}
+	params := "initial=true"
+	if token != "" {
+		params = "sync_token=" + url.QueryEscape(token)
+	}
+	// sync responses hold all locales and fields which are not localized only in the default locale
+	c.localesMu.RLock()
+	known := c.defaultLocale != ""
+	c.localesMu.RUnlock()
+	if !known {
+		if _, err := c.Locales(); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	chain := c.fallbackChain(c.Locale)
+	for {
+		data, err := c.requestSync(params)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		for _, item := range data.Items {
+			switch item.Sys.Type {
+			case "Entry":
+				fields, err := localizedFields(item.Fields, chain)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				entries = append(entries, includeEntry{
+					Fields: fields,
+					Sys:    item.Sys,
+				})
+			case "Asset":
+				fields, err := localizedFields(item.Fields, chain)
+				if err != nil {
+					return nil, nil, nil, err
+				}
+				asset := includeAsset{Sys: item.Sys}
+				if err := json.Unmarshal(*fields, &asset.Fields); err != nil {
+					return nil, nil, nil, err
+				}
+				assets = append(assets, asset)
+			case "DeletedEntry":
+				result.DeletedEntries = append(result.DeletedEntries, item.Sys.ID)
+			case "DeletedAsset":
+				result.DeletedAssets = append(result.DeletedAssets, item.Sys.ID)
+			}
+		}
+		if data.NextPageURL == "" {
+			result.Token, err = syncToken(data.NextSyncURL)
+			return entries, assets, result, err
+		}
+		next, err := syncToken(data.NextPageURL)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		params = "sync_token=" + url.QueryEscape(next)
+	}
+}
+
+// Sync returns all changes since the sync which returned token, or the entire space for an empty token. Changed entries are evicted from the client cache
+func (c *ContentClient) Sync(token string) (*SyncResult, error) {
+	entries, assets, result, err := c.syncPages(token)
+	if err != nil {
+		return nil, err
+	}
+	// links are resolved within the changes, the client cache may hold outdated versions
+	index := newLinkIndex(entries, includes{Assets: assets})
+	cache := newIteratorCache()
+	for _, entry := range entries {
+		c.cache.evict("Entry:" + entry.Sys.ID)
+		switch entry.Sys.ContentType.Sys.ID {
+		case "2wKn6yEnZewu2SCCkus4as":
+			if v, ok := resolvePost(entry.Sys.ID, index, cache); ok {
+				result.Posts = append(result.Posts, &v)
+			}
+		case "1kUEViTN4EmGiEaaeC6ouY":
+			if v, ok := resolveAuthor(entry.Sys.ID, index, cache); ok {
+				result.Authors = append(result.Authors, &v)
+			}
+		case "5KMiN6YPvi42icqAUQMCQe":
+			if v, ok := resolveCategory(entry.Sys.ID, index, cache); ok {
+				result.Categories = append(result.Categories, &v)
+			}
+		}
+	}
+	for _, raw := range assets {
+		c.cache.evict("Asset:" + raw.Sys.ID)
+		asset := toAsset(raw)
+		result.Assets = append(result.Assets, &asset)
+	}
+	for _, id := range result.DeletedEntries {
+		c.cache.evict("Entry:" + id)
+	}
+	for _, id := range result.DeletedAssets {
+		c.cache.evict("Asset:" + id)
+	}
+	return result, nil
+}
+
+// Store keeps all entries and assets of a space in memory. It is filled and updated through the sync api and resolves links across the whole space. Store is safe for concurrent use
+type Store struct {
+	c      *ContentClient
+	mu     sync.RWMutex
+	token  string
+	index  *linkIndex
+	unique map[string]map[string][]string
+}
+
+// storeSnapshot is the file representation of a Store
+type storeSnapshot struct {
+	Locale  string          `json:"locale"`
+	Token   string          `json:"token"`
+	Entries []*includeEntry `json:"entries"`
+	Assets  []*includeAsset `json:"assets"`
+}
+
+// NewStore returns an empty store. Call Sync or Restore to fill it
+func (c *ContentClient) NewStore() *Store {
+	return &Store{
+		c:      c,
+		index:  newLinkIndex(nil, includes{}),
+		unique: map[string]map[string][]string{},
+	}
+}
+
+// Sync applies all changes since the previous sync, or the entire space on the first call, to the store
+func (s *Store) Sync() error {
+	s.mu.RLock()
+	token := s.token
+	s.mu.RUnlock()
+	entries, assets, result, err := s.c.syncPages(token)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range entries {
+		s.index.entries[entries[i].Sys.ID] = &entries[i]
+	}
+	for i := range assets {
+		s.index.assets[assets[i].Sys.ID] = &assets[i]
+	}
+	for _, id := range result.DeletedEntries {
+		delete(s.index.entries, id)
+	}
+	for _, id := range result.DeletedAssets {
+		delete(s.index.assets, id)
+	}
+	if err := s.reindex(); err != nil {
+		return err
+	}
+	s.token = result.Token
+	return nil
+}
+
+// reindex rebuilds the lookup tables of unique fields. Values shared by several entries are kept so lookups can report them. The caller must hold the write lock
+func (s *Store) reindex() error {
+	s.unique = map[string]map[string][]string{}
+	s.unique["2wKn6yEnZewu2SCCkus4as.slug"] = map[string][]string{}
+	for id, entry := range s.index.entries {
+		switch entry.Sys.ContentType.Sys.ID {
+		case "2wKn6yEnZewu2SCCkus4as":
+			var item postItem
+			if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
+				return err
+			}
+			s.unique["2wKn6yEnZewu2SCCkus4as.slug"][item.Fields.Slug] = append(s.unique["2wKn6yEnZewu2SCCkus4as.slug"][item.Fields.Slug], id)
+		}
+	}
+	return nil
+}
+
+// Snapshot writes the contents of the store to a file, replacing it atomically
+func (s *Store) Snapshot(path string) error {
+	s.mu.RLock()
+	snapshot := storeSnapshot{
+		Locale: s.c.Locale,
+		Token:  s.token,
+	}
+	for _, entry := range s.index.entries {
+		snapshot.Entries = append(snapshot.Entries, entry)
+	}
+	for _, asset := range s.index.assets {
+		snapshot.Assets = append(snapshot.Assets, asset)
+	}
+	b, err := json.Marshal(snapshot)
+	s.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(b); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return err
+	}
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// Restore replaces the contents of the store with a snapshot. Later syncs continue from the snapshot
+func (s *Store) Restore(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var snapshot storeSnapshot
+	if err := json.Unmarshal(b, &snapshot); err != nil {
+		return err
+	}
+	if snapshot.Locale != s.c.Locale {
+		return fmt.Errorf("snapshot locale %s does not match client locale %s", snapshot.Locale, s.c.Locale)
+	}
+	index := newLinkIndex(nil, includes{})
+	for _, entry := range snapshot.Entries {
+		index.entries[entry.Sys.ID] = entry
+	}
+	for _, asset := range snapshot.Assets {
+		index.assets[asset.Sys.ID] = asset
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	previous, unique := s.index, s.unique
+	s.index = index
+	if err := s.reindex(); err != nil {
+		s.index, s.unique = previous, unique
+		return err
+	}
+	s.token = snapshot.Token
+	return nil
+}
+
+// Asset returns a single Asset of the store
+func (s *Store) Asset(id string) (*Asset, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	raw, ok := s.index.assets[id]
+	if !ok {
+		return nil, &NotFoundError{ID: id}
+	}
+	asset := toAsset(*raw)
+	return &asset, nil
+}
+
+// Post returns a single Post of the store with all links resolved
+func (s *Store) Post(id string) (*Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.findPost(id)
+}
+
+// findPost resolves a single Post of the store. The caller must hold the read lock
+func (s *Store) findPost(id string) (*Post, error) {
+	item, ok := resolvePost(id, s.index, newIteratorCache())
+	if !ok {
+		return nil, &NotFoundError{
+			ContentType: "2wKn6yEnZewu2SCCkus4as",
+			ID:          id,
+		}
+	}
+	return &item, nil
+}
+
+// Posts returns all Post entries of the store ordered by ID
+func (s *Store) Posts() []*Post {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []string
+	for id, entry := range s.index.entries {
+		if entry.Sys.ContentType.Sys.ID == "2wKn6yEnZewu2SCCkus4as" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	cache := newIteratorCache()
+	items := make([]*Post, 0, len(ids))
+	for _, id := range ids {
+		if item, ok := resolvePost(id, s.index, cache); ok {
+			items = append(items, &item)
+		}
+	}
+	return items
+}
+
+// PostBySlug returns the single Post of the store with the given slug. A *DuplicateError is returned if several entries share the value
+func (s *Store) PostBySlug(v string) (*Post, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ids := s.unique["2wKn6yEnZewu2SCCkus4as.slug"][v]
+	if len(ids) == 0 {
+		return nil, &NotFoundError{
+			ContentType: "2wKn6yEnZewu2SCCkus4as",
+			Field:       "slug",
+			ID:          v,
+		}
+	}
+	if len(ids) > 1 {
+		return nil, &DuplicateError{
+			Field: "Slug",
+			Type:  "Post",
+			Value: v,
+		}
+	}
+	return s.findPost(ids[0])
+}
+
+// Author returns a single Author of the store with all links resolved
+func (s *Store) Author(id string) (*Author, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.findAuthor(id)
+}
+
+// findAuthor resolves a single Author of the store. The caller must hold the read lock
+func (s *Store) findAuthor(id string) (*Author, error) {
+	item, ok := resolveAuthor(id, s.index, newIteratorCache())
+	if !ok {
+		return nil, &NotFoundError{
+			ContentType: "1kUEViTN4EmGiEaaeC6ouY",
+			ID:          id,
+		}
+	}
+	return &item, nil
+}
+
+// Authors returns all Author entries of the store ordered by ID
+func (s *Store) Authors() []*Author {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []string
+	for id, entry := range s.index.entries {
+		if entry.Sys.ContentType.Sys.ID == "1kUEViTN4EmGiEaaeC6ouY" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	cache := newIteratorCache()
+	items := make([]*Author, 0, len(ids))
+	for _, id := range ids {
+		if item, ok := resolveAuthor(id, s.index, cache); ok {
+			items = append(items, &item)
+		}
+	}
+	return items
+}
+
+// Category returns a single Category of the store with all links resolved
+func (s *Store) Category(id string) (*Category, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.findCategory(id)
+}
+
+// findCategory resolves a single Category of the store. The caller must hold the read lock
+func (s *Store) findCategory(id string) (*Category, error) {
+	item, ok := resolveCategory(id, s.index, newIteratorCache())
+	if !ok {
+		return nil, &NotFoundError{
+			ContentType: "5KMiN6YPvi42icqAUQMCQe",
+			ID:          id,
+		}
+	}
+	return &item, nil
+}
+
+// Categories returns all Category entries of the store ordered by ID
+func (s *Store) Categories() []*Category {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var ids []string
+	for id, entry := range s.index.entries {
+		if entry.Sys.ContentType.Sys.ID == "5KMiN6YPvi42icqAUQMCQe" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	cache := newIteratorCache()
+	items := make([]*Category, 0, len(ids))
+	for _, id := range ids {
+		if item, ok := resolveCategory(id, s.index, cache); ok {
+			items = append(items, &item)
+		}
+	}
+	return items
+}
+
+// toAsset converts a raw contentful asset into an Asset
+func toAsset(asset includeAsset) Asset {
+	return Asset{
+		ContentType: asset.Fields.File.ContentType,
+		Description: asset.Fields.Description,
+		FileName:    asset.Fields.File.FileName,
+		Height:      asset.Fields.File.Details.Image.Height,
+		ID:          asset.Sys.ID,
+		Size:        asset.Fields.File.Details.Size,
+		Title:       asset.Fields.Title,
+		URL:         fmt.Sprintf("https:%s", asset.Fields.File.URL),
+		Width:       asset.Fields.File.Details.Image.Width,
+	}
+}
+
+// AssetListOptions contains pagination and filter configuration for asset iterators. Locale overrides the client locale if set
+type AssetListOptions struct {
+	Page          int
+	Limit         int
+	MimetypeGroup string
+	Locale        string
+}
+
+// AssetIterator is used to paginate result sets of Asset
+type AssetIterator struct {
+	Limit         int
+	Offset        int
+	total         int
+	fetched       bool
+	MimetypeGroup string
+	c             *ContentClient
+	locale        string
+	items         []*Asset
+}
+
+// assetsResponse holds an entire contentful asset response
+type assetsResponse struct {
+	Total int            `json:"total"`
+	Skip  int            `json:"skip"`
+	Limit int            `json:"limit"`
+	Items []includeAsset `json:"items"`
+}
+
+// setPage records a fetched page in the pagination state
+func (it *AssetIterator) setPage(items []*Asset, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type Asset. If none exists a network request will be executed
+func (it *AssetIterator) Next() (*Asset, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *Asset
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of Asset. If none exists a network request will be executed
+func (it *AssetIterator) Page() ([]*Asset, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining Asset items. Iteration stops after the first error
+func (it *AssetIterator) All() iter.Seq2[*Asset, error] {
+	return func(yield func(*Asset, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining Asset items. A max of zero or less collects all items
+func (it *AssetIterator) Collect(max int) ([]*Asset, error) {
+	var items []*Asset
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining Asset item and stops at the first error
+func (it *AssetIterator) ForEach(fn func(*Asset) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *AssetIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type Asset
+func (it *AssetIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *AssetIterator) fetch() error {
+	c := it.c
+	chain := c.fallbackChain(it.locale)
+	group := url.QueryEscape(it.MimetypeGroup)
+	var url = fmt.Sprintf("%s/spaces/%s/assets?access_token=%s&locale=%s&limit=%d&skip=%d&order=%s", c.host, c.spaceID, c.authToken, localeQuery(chain), it.Limit, it.Offset, defaultOrder)
+	if it.MimetypeGroup != "" {
+		url += "&mimetype_group=" + group
+	}
+	resp, err := c.get(url)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return responseError(resp)
+	}
+	var data assetsResponse
+	if err := decodeResponse(resp, chain, &data); err != nil {
+		return err
+	}
+	var items = make([]*Asset, len(data.Items))
+	for i, raw := range data.Items {
+		asset := toAsset(raw)
+		items[i] = &asset
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// Assets retrieves paginated Asset entries
+func (c *ContentClient) Assets(opts AssetListOptions) *AssetIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	if opts.Locale == "" {
+		opts.Locale = c.Locale
+	}
+	it := &AssetIterator{
+		Limit:         opts.Limit,
+		MimetypeGroup: opts.MimetypeGroup,
+		Offset:        opts.Page * opts.Limit,
+		c:             c,
+		locale:        opts.Locale,
+	}
+	return it
+}
+
+// AssetOptions contains configuration for fetching a single Asset. Locale overrides the client locale if set
+type AssetOptions struct {
+	Locale string
+}
+
+// Asset retrieves a single Asset by its ID, in the locale of opts if given
+func (c *ContentClient) Asset(id string, opts ...AssetOptions) (*Asset, error) {
+	locale := c.Locale
+	if len(opts) > 0 && opts[0].Locale != "" {
+		locale = opts[0].Locale
+	}
+	path := url.PathEscape(id)
+	chain := c.fallbackChain(locale)
+	var url = fmt.Sprintf("%s/spaces/%s/assets/%s?access_token=%s&locale=%s", c.host, c.spaceID, path, c.authToken, localeQuery(chain))
+	resp, err := c.get(url)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, &NotFoundError{ID: id}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, responseError(resp)
+	}
+	var raw includeAsset
+	if err := decodeResponse(resp, chain, &raw); err != nil {
+		return nil, err
+	}
+	asset := toAsset(raw)
+	return &asset, nil
+}
+
+// ManagementClient implements a space specific contentful client
+type ManagementClient struct {
+	host       string
+	uploadHost string
+	spaceID    string
+	authToken  string
+	client     *http.Client
+	pool       *x509.CertPool
+}
+
+// NewManagement returns a contentful client interfacing with the content management api
+func NewManagement(authToken string) *ManagementClient {
+	pool := x509.NewCertPool()
+	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
+	return &ManagementClient{
+		authToken:  authToken,
+		client:     &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
+		host:       fmt.Sprintf("https://%s", contentfulCMAURL),
+		pool:       pool,
+		spaceID:    "ygx37epqlss8",
+		uploadHost: fmt.Sprintf("https://%s", contentfulUploadURL),
+	}
+}
+
+// do sends a management api request with in as json payload unless it is nil and decodes the response into out unless it is nil
+func (c *ManagementClient) do(method, path string, header http.Header, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, fmt.Sprintf("%s/spaces/%s%s", c.host, c.spaceID, path), body)
+	if err != nil {
+		return err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.authToken))
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return responseError(resp)
+	}
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		return resp.Body.Close()
+	}
+	return decodeResponse(resp, nil, out)
+}
+
+// versionHeader returns the header sending the version of a resource to update
+func versionHeader(version int) http.Header {
+	return http.Header{"X-Contentful-Version": []string{strconv.Itoa(version)}}
+}
+
+// maxUpdateAttempts bounds the attempts of UpdateWithRetry
+const maxUpdateAttempts = 5
+
+// updateWithRetry fetches a resource, applies fn and updates it until the update succeeds without a version conflict or maxUpdateAttempts is reached
+func updateWithRetry[T any](get func() (*T, error), update func(*T) error, fn func(*T) error) (*T, error) {
+	for attempt := 1; ; attempt++ {
+		v, err := get()
+		if err != nil {
+			return nil, err
+		}
+		if err := fn(v); err != nil {
+			return nil, err
+		}
+		err = update(v)
+		if err == nil {
+			return v, nil
+		}
+		if !errors.Is(err, ErrVersionMismatch) || attempt >= maxUpdateAttempts {
+			return nil, err
+		}
+	}
+}
+
+// Link references an entry or asset in management api payloads
+type Link struct {
+	Sys LinkSys `json:"sys"`
+}
+
+// LinkSys holds the target of a Link
+type LinkSys struct {
+	Type     string `json:"type"`
+	LinkType string `json:"linkType"`
+	ID       string `json:"id"`
+}
+
+// EntryLink returns a link to the entry with the given ID
+func EntryLink(id string) Link {
+	return Link{Sys: LinkSys{
+		ID:       id,
+		LinkType: "Entry",
+		Type:     "Link",
+	}}
+}
+
+// AssetLink returns a link to the asset with the given ID
+func AssetLink(id string) Link {
+	return Link{Sys: LinkSys{
+		ID:       id,
+		LinkType: "Asset",
+		Type:     "Link",
+	}}
+}
+
+// Webhook describes a webhook definition
+type Webhook struct {
+	ID      string   `json:"-"`
+	Version int      `json:"-"`
+	URL     string   `json:"url"`
+	Name    string   `json:"name"`
+	Topics  []string `json:"topics"`
+}
+
+// WebhookIterator is used to paginate webhooks
+type WebhookIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*Webhook
+}
+type webhookItem struct {
+	Sys sys `json:"sys"`
+	Webhook
+}
+type webhooksResponse struct {
+	Total int           `json:"total"`
+	Skip  int           `json:"skip"`
+	Limit int           `json:"limit"`
+	Items []webhookItem `json:"items"`
+}
+
+// setPage records a fetched page in the pagination state
+func (it *WebhookIterator) setPage(items []*Webhook, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type Webhook. If none exists a network request will be executed
+func (it *WebhookIterator) Next() (*Webhook, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *Webhook
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of Webhook. If none exists a network request will be executed
+func (it *WebhookIterator) Page() ([]*Webhook, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining Webhook items. Iteration stops after the first error
+func (it *WebhookIterator) All() iter.Seq2[*Webhook, error] {
+	return func(yield func(*Webhook, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining Webhook items. A max of zero or less collects all items
+func (it *WebhookIterator) Collect(max int) ([]*Webhook, error) {
+	var items []*Webhook
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining Webhook item and stops at the first error
+func (it *WebhookIterator) ForEach(fn func(*Webhook) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *WebhookIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type Webhook
+func (it *WebhookIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *WebhookIterator) fetch() error {
+	var data webhooksResponse
+	if err := it.c.do("GET", fmt.Sprintf("/webhook_definitions?limit=%d&skip=%d", it.Limit, it.Offset), nil, nil, &data); err != nil {
+		return err
+	}
+	var items = make([]*Webhook, len(data.Items))
+	for j, i := range data.Items {
+		i.Webhook.ID = i.Sys.ID
+		i.Webhook.Version = i.Sys.Version
+		webhook := i.Webhook
+		items[j] = &webhook
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// List retrieves paginated webhooks
+func (ws *WebhookService) List(opts ListOptions) *WebhookIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	it := &WebhookIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      ws.client,
+	}
+	return it
+}
+
+// Create adds a new webhook definitions
+func (ws *WebhookService) Create(w *Webhook) error {
+	var payload = webhookItem{Webhook: *w}
+	if err := ws.client.do("POST", "/webhook_definitions", nil, payload.Webhook, &payload); err != nil {
+		return err
+	}
+	w.ID = payload.Sys.ID
+	w.Version = payload.Sys.Version
+	return nil
+}
+
+// Update changes an existing webhook definitions
+func (ws *WebhookService) Update(w *Webhook) error {
+	var payload = webhookItem{Webhook: *w}
+	if err := ws.client.do("PUT", "/webhook_definitions/"+url.PathEscape(w.ID), versionHeader(w.Version), payload.Webhook, &payload); err != nil {
+		return err
+	}
+	*w = payload.Webhook
+	w.ID = payload.Sys.ID
+	w.Version = payload.Sys.Version
+	return nil
+}
+
+// Get retrieves a single webhook definition by its ID
+func (ws *WebhookService) Get(id string) (*Webhook, error) {
+	var payload webhookItem
+	if err := ws.client.do("GET", "/webhook_definitions/"+url.PathEscape(id), nil, nil, &payload); err != nil {
+		return nil, err
+	}
+	payload.Webhook.ID = payload.Sys.ID
+	payload.Webhook.Version = payload.Sys.Version
+	return &payload.Webhook, nil
+}
+
+// UpdateWithRetry applies fn to the latest version of a webhook definition and updates it, retrying with a fresh version on conflicts
+func (ws *WebhookService) UpdateWithRetry(id string, fn func(*Webhook) error) (*Webhook, error) {
+	return updateWithRetry(func() (*Webhook, error) {
+		return ws.Get(id)
+	}, ws.Update, fn)
+}
+
+// Delete adds a new webhook definitions
+func (ws *WebhookService) Delete(id string) error {
+	return ws.client.do("DELETE", "/webhook_definitions/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// WebhookService includes webhook management functions
+type WebhookService struct {
+	client *ManagementClient
+}
+
+// Webhooks returns a Webhook management service
+func (c *ManagementClient) Webhooks() *WebhookService {
+	return &WebhookService{client: c}
+}
+
+// PostEntryFields holds the Post fields in the management api format, mapping locale codes to values
+type PostEntryFields struct {
+	Title         map[string]string   `json:"title,omitempty"`
+	Slug          map[string]string   `json:"slug,omitempty"`
+	Author        map[string][]Link   `json:"author,omitempty"`
+	Body          map[string]string   `json:"body,omitempty"`
+	Category      map[string][]Link   `json:"category,omitempty"`
+	Tags          map[string][]string `json:"tags,omitempty"`
+	FeaturedImage map[string]Link     `json:"featuredImage,omitempty"`
+	Date          map[string]Date     `json:"date,omitempty"`
+	Comments      map[string]bool     `json:"comments,omitempty"`
+	Approver      map[string]Link     `json:"approver,omitempty"`
+	AuthorOrPost  map[string][]Link   `json:"authorOrPost,omitempty"`
+}
+
+// PostEntry is a Post entry of the management api
+type PostEntry struct {
+	ID      string          `json:"-"`
+	Version int             `json:"-"`
+	Fields  PostEntryFields `json:"fields"`
+}
+type postEntryItem struct {
+	Sys    sys             `json:"sys"`
+	Fields PostEntryFields `json:"fields"`
+}
+
+// PostEntryService includes management functions for Post entries
+type PostEntryService struct {
+	client *ManagementClient
+}
+
+// Posts returns a Post entry management service
+func (c *ManagementClient) Posts() *PostEntryService {
+	return &PostEntryService{client: c}
+}
+
+// send executes an entry request with an optional payload and updates e from the response
+func (s *PostEntryService) send(method, path string, header http.Header, e *PostEntry, in interface{}) error {
+	var out postEntryItem
+	if err := s.client.do(method, path, header, in, &out); err != nil {
+		return err
+	}
+	if out.Sys.ContentType.Sys.ID != "2wKn6yEnZewu2SCCkus4as" {
+		return &NotFoundError{
+			ContentType: "2wKn6yEnZewu2SCCkus4as",
+			ID:          out.Sys.ID,
+		}
+	}
+	e.ID = out.Sys.ID
+	e.Version = out.Sys.Version
+	e.Fields = out.Fields
+	return nil
+}
+
+// Get retrieves a single Post entry by its ID
+func (s *PostEntryService) Get(id string) (*PostEntry, error) {
+	e := &PostEntry{ID: id}
+	if err := s.send("GET", "/entries/"+url.PathEscape(e.ID)+"", nil, e, nil); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Create adds a new Post entry. The entry is created with the given ID if set
+func (s *PostEntryService) Create(e *PostEntry) error {
+	header := http.Header{"X-Contentful-Content-Type": []string{"2wKn6yEnZewu2SCCkus4as"}}
+	if e.ID == "" {
+		return s.send("POST", "/entries", header, e, postEntryItem{Fields: e.Fields})
+	}
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", header, e, postEntryItem{Fields: e.Fields})
+}
+
+// Update replaces the fields of an existing Post entry. It fails if the entry changed since e.Version
+func (s *PostEntryService) Update(e *PostEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", versionHeader(e.Version), e, postEntryItem{Fields: e.Fields})
+}
+
+// UpdateWithRetry applies fn to the latest version of a Post entry and updates it, retrying with a fresh version on conflicts
+func (s *PostEntryService) UpdateWithRetry(id string, fn func(*PostEntry) error) (*PostEntry, error) {
+	return updateWithRetry(func() (*PostEntry, error) {
+		return s.Get(id)
+	}, s.Update, fn)
+}
+
+// Delete removes an unpublished Post entry
+func (s *PostEntryService) Delete(id string) error {
+	return s.client.do("DELETE", "/entries/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// Publish makes the current version of a Post entry available in the delivery api
+func (s *PostEntryService) Publish(e *PostEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Unpublish removes a Post entry from the delivery api
+func (s *PostEntryService) Unpublish(e *PostEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Archive archives an unpublished Post entry
+func (s *PostEntryService) Archive(e *PostEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// Unarchive restores an archived Post entry
+func (s *PostEntryService) Unarchive(e *PostEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// PostEntryIterator is used to paginate Post entries of the management api
+type PostEntryIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*PostEntry
+}
+
+// setPage records a fetched page in the pagination state
+func (it *PostEntryIterator) setPage(items []*PostEntry, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type PostEntry. If none exists a network request will be executed
+func (it *PostEntryIterator) Next() (*PostEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *PostEntry
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of PostEntry. If none exists a network request will be executed
+func (it *PostEntryIterator) Page() ([]*PostEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining PostEntry items. Iteration stops after the first error
+func (it *PostEntryIterator) All() iter.Seq2[*PostEntry, error] {
+	return func(yield func(*PostEntry, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining PostEntry items. A max of zero or less collects all items
+func (it *PostEntryIterator) Collect(max int) ([]*PostEntry, error) {
+	var items []*PostEntry
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining PostEntry item and stops at the first error
+func (it *PostEntryIterator) ForEach(fn func(*PostEntry) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *PostEntryIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type PostEntry
+func (it *PostEntryIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *PostEntryIterator) fetch() error {
+	var data struct {
+		Total int             `json:"total"`
+		Items []postEntryItem `json:"items"`
+	}
+	if err := it.c.do("GET", fmt.Sprintf("/entries?content_type=2wKn6yEnZewu2SCCkus4as&limit=%d&skip=%d&order=%s", it.Limit, it.Offset, defaultOrder), nil, nil, &data); err != nil {
+		return err
+	}
+	items := make([]*PostEntry, len(data.Items))
+	for i, raw := range data.Items {
+		items[i] = &PostEntry{
+			Fields:  raw.Fields,
+			ID:      raw.Sys.ID,
+			Version: raw.Sys.Version,
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// List retrieves paginated Post entries including drafts
+func (s *PostEntryService) List(opts ListOptions) *PostEntryIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &PostEntryIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      s.client,
+	}
+}
+
+// AuthorEntryFields holds the Author fields in the management api format, mapping locale codes to values
+type AuthorEntryFields struct {
+	Name           map[string]string  `json:"name,omitempty"`
+	Website        map[string]string  `json:"website,omitempty"`
+	ProfilePhoto   map[string]Link    `json:"profilePhoto,omitempty"`
+	Biography      map[string]string  `json:"biography,omitempty"`
+	CreatedEntries map[string][]Link  `json:"createdEntries,omitempty"`
+	Age            map[string]int64   `json:"age,omitempty"`
+	Rating         map[string]float64 `json:"rating,omitempty"`
+}
+
+// AuthorEntry is a Author entry of the management api
+type AuthorEntry struct {
+	ID      string            `json:"-"`
+	Version int               `json:"-"`
+	Fields  AuthorEntryFields `json:"fields"`
+}
+type authorEntryItem struct {
+	Sys    sys               `json:"sys"`
+	Fields AuthorEntryFields `json:"fields"`
+}
+
+// AuthorEntryService includes management functions for Author entries
+type AuthorEntryService struct {
+	client *ManagementClient
+}
+
+// Authors returns a Author entry management service
+func (c *ManagementClient) Authors() *AuthorEntryService {
+	return &AuthorEntryService{client: c}
+}
+
+// send executes an entry request with an optional payload and updates e from the response
+func (s *AuthorEntryService) send(method, path string, header http.Header, e *AuthorEntry, in interface{}) error {
+	var out authorEntryItem
+	if err := s.client.do(method, path, header, in, &out); err != nil {
+		return err
+	}
+	if out.Sys.ContentType.Sys.ID != "1kUEViTN4EmGiEaaeC6ouY" {
+		return &NotFoundError{
+			ContentType: "1kUEViTN4EmGiEaaeC6ouY",
+			ID:          out.Sys.ID,
+		}
+	}
+	e.ID = out.Sys.ID
+	e.Version = out.Sys.Version
+	e.Fields = out.Fields
+	return nil
+}
+
+// Get retrieves a single Author entry by its ID
+func (s *AuthorEntryService) Get(id string) (*AuthorEntry, error) {
+	e := &AuthorEntry{ID: id}
+	if err := s.send("GET", "/entries/"+url.PathEscape(e.ID)+"", nil, e, nil); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Create adds a new Author entry. The entry is created with the given ID if set
+func (s *AuthorEntryService) Create(e *AuthorEntry) error {
+	header := http.Header{"X-Contentful-Content-Type": []string{"1kUEViTN4EmGiEaaeC6ouY"}}
+	if e.ID == "" {
+		return s.send("POST", "/entries", header, e, authorEntryItem{Fields: e.Fields})
+	}
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", header, e, authorEntryItem{Fields: e.Fields})
+}
+
+// Update replaces the fields of an existing Author entry. It fails if the entry changed since e.Version
+func (s *AuthorEntryService) Update(e *AuthorEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", versionHeader(e.Version), e, authorEntryItem{Fields: e.Fields})
+}
+
+// UpdateWithRetry applies fn to the latest version of a Author entry and updates it, retrying with a fresh version on conflicts
+func (s *AuthorEntryService) UpdateWithRetry(id string, fn func(*AuthorEntry) error) (*AuthorEntry, error) {
+	return updateWithRetry(func() (*AuthorEntry, error) {
+		return s.Get(id)
+	}, s.Update, fn)
+}
+
+// Delete removes an unpublished Author entry
+func (s *AuthorEntryService) Delete(id string) error {
+	return s.client.do("DELETE", "/entries/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// Publish makes the current version of a Author entry available in the delivery api
+func (s *AuthorEntryService) Publish(e *AuthorEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Unpublish removes a Author entry from the delivery api
+func (s *AuthorEntryService) Unpublish(e *AuthorEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Archive archives an unpublished Author entry
+func (s *AuthorEntryService) Archive(e *AuthorEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// Unarchive restores an archived Author entry
+func (s *AuthorEntryService) Unarchive(e *AuthorEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// AuthorEntryIterator is used to paginate Author entries of the management api
+type AuthorEntryIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*AuthorEntry
+}
+
+// setPage records a fetched page in the pagination state
+func (it *AuthorEntryIterator) setPage(items []*AuthorEntry, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type AuthorEntry. If none exists a network request will be executed
+func (it *AuthorEntryIterator) Next() (*AuthorEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *AuthorEntry
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of AuthorEntry. If none exists a network request will be executed
+func (it *AuthorEntryIterator) Page() ([]*AuthorEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining AuthorEntry items. Iteration stops after the first error
+func (it *AuthorEntryIterator) All() iter.Seq2[*AuthorEntry, error] {
+	return func(yield func(*AuthorEntry, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining AuthorEntry items. A max of zero or less collects all items
+func (it *AuthorEntryIterator) Collect(max int) ([]*AuthorEntry, error) {
+	var items []*AuthorEntry
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining AuthorEntry item and stops at the first error
+func (it *AuthorEntryIterator) ForEach(fn func(*AuthorEntry) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *AuthorEntryIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type AuthorEntry
+func (it *AuthorEntryIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *AuthorEntryIterator) fetch() error {
+	var data struct {
+		Total int               `json:"total"`
+		Items []authorEntryItem `json:"items"`
+	}
+	if err := it.c.do("GET", fmt.Sprintf("/entries?content_type=1kUEViTN4EmGiEaaeC6ouY&limit=%d&skip=%d&order=%s", it.Limit, it.Offset, defaultOrder), nil, nil, &data); err != nil {
+		return err
+	}
+	items := make([]*AuthorEntry, len(data.Items))
+	for i, raw := range data.Items {
+		items[i] = &AuthorEntry{
+			Fields:  raw.Fields,
+			ID:      raw.Sys.ID,
+			Version: raw.Sys.Version,
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// List retrieves paginated Author entries including drafts
+func (s *AuthorEntryService) List(opts ListOptions) *AuthorEntryIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &AuthorEntryIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      s.client,
+	}
+}
+
+// CategoryEntryFields holds the Category fields in the management api format, mapping locale codes to values
+type CategoryEntryFields struct {
+	Title            map[string]string `json:"title,omitempty"`
+	ShortDescription map[string]string `json:"shortDescription,omitempty"`
+	Icon             map[string]Link   `json:"icon,omitempty"`
+	Parent           map[string]Link   `json:"parent,omitempty"`
+}
+
+// CategoryEntry is a Category entry of the management api
+type CategoryEntry struct {
+	ID      string              `json:"-"`
+	Version int                 `json:"-"`
+	Fields  CategoryEntryFields `json:"fields"`
+}
+type categoryEntryItem struct {
+	Sys    sys                 `json:"sys"`
+	Fields CategoryEntryFields `json:"fields"`
+}
+
+// CategoryEntryService includes management functions for Category entries
+type CategoryEntryService struct {
+	client *ManagementClient
+}
+
+// Categories returns a Category entry management service
+func (c *ManagementClient) Categories() *CategoryEntryService {
+	return &CategoryEntryService{client: c}
+}
+
+// send executes an entry request with an optional payload and updates e from the response
+func (s *CategoryEntryService) send(method, path string, header http.Header, e *CategoryEntry, in interface{}) error {
+	var out categoryEntryItem
+	if err := s.client.do(method, path, header, in, &out); err != nil {
+		return err
+	}
+	if out.Sys.ContentType.Sys.ID != "5KMiN6YPvi42icqAUQMCQe" {
+		return &NotFoundError{
+			ContentType: "5KMiN6YPvi42icqAUQMCQe",
+			ID:          out.Sys.ID,
+		}
+	}
+	e.ID = out.Sys.ID
+	e.Version = out.Sys.Version
+	e.Fields = out.Fields
+	return nil
+}
+
+// Get retrieves a single Category entry by its ID
+func (s *CategoryEntryService) Get(id string) (*CategoryEntry, error) {
+	e := &CategoryEntry{ID: id}
+	if err := s.send("GET", "/entries/"+url.PathEscape(e.ID)+"", nil, e, nil); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Create adds a new Category entry. The entry is created with the given ID if set
+func (s *CategoryEntryService) Create(e *CategoryEntry) error {
+	header := http.Header{"X-Contentful-Content-Type": []string{"5KMiN6YPvi42icqAUQMCQe"}}
+	if e.ID == "" {
+		return s.send("POST", "/entries", header, e, categoryEntryItem{Fields: e.Fields})
+	}
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", header, e, categoryEntryItem{Fields: e.Fields})
+}
+
+// Update replaces the fields of an existing Category entry. It fails if the entry changed since e.Version
+func (s *CategoryEntryService) Update(e *CategoryEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"", versionHeader(e.Version), e, categoryEntryItem{Fields: e.Fields})
+}
+
+// UpdateWithRetry applies fn to the latest version of a Category entry and updates it, retrying with a fresh version on conflicts
+func (s *CategoryEntryService) UpdateWithRetry(id string, fn func(*CategoryEntry) error) (*CategoryEntry, error) {
+	return updateWithRetry(func() (*CategoryEntry, error) {
+		return s.Get(id)
+	}, s.Update, fn)
+}
+
+// Delete removes an unpublished Category entry
+func (s *CategoryEntryService) Delete(id string) error {
+	return s.client.do("DELETE", "/entries/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// Publish makes the current version of a Category entry available in the delivery api
+func (s *CategoryEntryService) Publish(e *CategoryEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Unpublish removes a Category entry from the delivery api
+func (s *CategoryEntryService) Unpublish(e *CategoryEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/published", versionHeader(e.Version), e, nil)
+}
+
+// Archive archives an unpublished Category entry
+func (s *CategoryEntryService) Archive(e *CategoryEntry) error {
+	return s.send("PUT", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// Unarchive restores an archived Category entry
+func (s *CategoryEntryService) Unarchive(e *CategoryEntry) error {
+	return s.send("DELETE", "/entries/"+url.PathEscape(e.ID)+"/archived", versionHeader(e.Version), e, nil)
+}
+
+// CategoryEntryIterator is used to paginate Category entries of the management api
+type CategoryEntryIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*CategoryEntry
+}
+
+// setPage records a fetched page in the pagination state
+func (it *CategoryEntryIterator) setPage(items []*CategoryEntry, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type CategoryEntry. If none exists a network request will be executed
+func (it *CategoryEntryIterator) Next() (*CategoryEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *CategoryEntry
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of CategoryEntry. If none exists a network request will be executed
+func (it *CategoryEntryIterator) Page() ([]*CategoryEntry, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining CategoryEntry items. Iteration stops after the first error
+func (it *CategoryEntryIterator) All() iter.Seq2[*CategoryEntry, error] {
+	return func(yield func(*CategoryEntry, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Collect returns up to max remaining CategoryEntry items. A max of zero or less collects all items
+func (it *CategoryEntryIterator) Collect(max int) ([]*CategoryEntry, error) {
+	var items []*CategoryEntry
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+	return items, nil
+}
+
+// ForEach calls fn for every remaining CategoryEntry item and stops at the first error
+func (it *CategoryEntryIterator) ForEach(fn func(*CategoryEntry) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *CategoryEntryIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type CategoryEntry
+func (it *CategoryEntryIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *CategoryEntryIterator) fetch() error {
+	var data struct {
+		Total int                 `json:"total"`
+		Items []categoryEntryItem `json:"items"`
+	}
+	if err := it.c.do("GET", fmt.Sprintf("/entries?content_type=5KMiN6YPvi42icqAUQMCQe&limit=%d&skip=%d&order=%s", it.Limit, it.Offset, defaultOrder), nil, nil, &data); err != nil {
+		return err
+	}
+	items := make([]*CategoryEntry, len(data.Items))
+	for i, raw := range data.Items {
+		items[i] = &CategoryEntry{
+			Fields:  raw.Fields,
+			ID:      raw.Sys.ID,
+			Version: raw.Sys.Version,
+		}
+	}
+	it.setPage(items, data.Total)
+	return nil
+}
+
+// List retrieves paginated Category entries including drafts
+func (s *CategoryEntryService) List(opts ListOptions) *CategoryEntryIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
+	}
+	return &CategoryEntryIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      s.client,
+	}
+}
+
+// patchOperation is a single JSON Patch operation
+type patchOperation struct {
+	Op            string      `json:"op"`
+	Path          string      `json:"path"`
+	Value         interface{} `json:"value,omitempty"`
+	field, locale string
+}
+
+// patchBuilder collects the JSON Patch operations shared by all generated patch builders
+type patchBuilder struct {
+	ops []patchOperation
+}
+
+func (p *patchBuilder) add(op, field, locale, suffix string, value interface{}) {
+	p.ops = append(p.ops, patchOperation{
+		Op:     op,
+		Path:   fieldPath(field, locale) + suffix,
+		Value:  value,
+		field:  field,
+		locale: locale,
+	})
+}
+
+// operations returns the operations of p for an entry with fields. JSON Patch cannot add to a missing parent, so a field or locale that is not set yet is created with the first value added to it
+func (p *patchBuilder) operations(fields interface{}) ([]patchOperation, error) {
+	b, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	set := map[string]map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &set); err != nil {
+		return nil, err
+	}
+	ops := make([]patchOperation, 0, len(p.ops))
+	for _, op := range p.ops {
+		locales, ok := set[op.field]
+		if op.Op == "remove" {
+			delete(locales, op.locale)
+			ops = append(ops, op)
+			continue
+		}
+		value := op.Value
+		if strings.HasSuffix(op.Path, "/-") {
+			if _, exists := locales[op.locale]; !exists {
+				value = []interface{}{op.Value}
+				op.Path = fieldPath(op.field, op.locale)
+				op.Value = value
+			}
+		}
+		if !ok {
+			locales = map[string]json.RawMessage{}
+			set[op.field] = locales
+			op.Path = "/fields/" + op.field
+			op.Value = map[string]interface{}{op.locale: value}
+		}
+		locales[op.locale] = nil
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// fieldPath returns the JSON Pointer of a localized field value
+func fieldPath(field, locale string) string {
+	escape := strings.NewReplacer("~", "~0", "/", "~1")
+	return "/fields/" + field + "/" + escape.Replace(locale)
+}
+
+// PostPatchBuilder builds JSON Patch updates of Post entries
+type PostPatchBuilder struct {
+	patchBuilder
+}
+
+// PostPatch returns an empty patch for Post entries
+func PostPatch() *PostPatchBuilder {
+	return &PostPatchBuilder{}
+}
+
+// SetTitle sets title in a locale
+func (p *PostPatchBuilder) SetTitle(locale string, v string) *PostPatchBuilder {
+	p.add("add", "title", locale, "", v)
+	return p
+}
+
+// RemoveTitle removes title in a locale
+func (p *PostPatchBuilder) RemoveTitle(locale string) *PostPatchBuilder {
+	p.add("remove", "title", locale, "", nil)
+	return p
+}
+
+// SetSlug sets slug in a locale
+func (p *PostPatchBuilder) SetSlug(locale string, v string) *PostPatchBuilder {
+	p.add("add", "slug", locale, "", v)
+	return p
+}
+
+// RemoveSlug removes slug in a locale
+func (p *PostPatchBuilder) RemoveSlug(locale string) *PostPatchBuilder {
+	p.add("remove", "slug", locale, "", nil)
+	return p
+}
+
+// SetAuthor replaces all values of author in a locale
+func (p *PostPatchBuilder) SetAuthor(locale string, vs ...string) *PostPatchBuilder {
+	values := make([]interface{}, len(vs))
+	for i, v := range vs {
+		values[i] = EntryLink(v)
+	}
+	p.add("add", "author", locale, "", values)
+	return p
+}
+
+// AddAuthor appends a value to author in a locale
+func (p *PostPatchBuilder) AddAuthor(locale string, v string) *PostPatchBuilder {
+	p.add("add", "author", locale, "/-", EntryLink(v))
+	return p
+}
+
+// RemoveAuthor removes author in a locale
+func (p *PostPatchBuilder) RemoveAuthor(locale string) *PostPatchBuilder {
+	p.add("remove", "author", locale, "", nil)
+	return p
+}
+
+// SetBody sets body in a locale
+func (p *PostPatchBuilder) SetBody(locale string, v string) *PostPatchBuilder {
+	p.add("add", "body", locale, "", v)
+	return p
+}
+
+// RemoveBody removes body in a locale
+func (p *PostPatchBuilder) RemoveBody(locale string) *PostPatchBuilder {
+	p.add("remove", "body", locale, "", nil)
+	return p
+}
+
+// SetCategory replaces all values of category in a locale
+func (p *PostPatchBuilder) SetCategory(locale string, vs ...string) *PostPatchBuilder {
+	values := make([]interface{}, len(vs))
+	for i, v := range vs {
+		values[i] = EntryLink(v)
+	}
+	p.add("add", "category", locale, "", values)
+	return p
+}
+
+// AddCategory appends a value to category in a locale
+func (p *PostPatchBuilder) AddCategory(locale string, v string) *PostPatchBuilder {
+	p.add("add", "category", locale, "/-", EntryLink(v))
+	return p
+}
+
+// RemoveCategory removes category in a locale
+func (p *PostPatchBuilder) RemoveCategory(locale string) *PostPatchBuilder {
+	p.add("remove", "category", locale, "", nil)
+	return p
+}
+
+// SetTags replaces all values of tags in a locale
+func (p *PostPatchBuilder) SetTags(locale string, vs ...string) *PostPatchBuilder {
+	values := make([]interface{}, len(vs))
+	for i, v := range vs {
+		values[i] = v
+	}
+	p.add("add", "tags", locale, "", values)
+	return p
+}
+
+// AddTag appends a value to tags in a locale
+func (p *PostPatchBuilder) AddTag(locale string, v string) *PostPatchBuilder {
+	p.add("add", "tags", locale, "/-", v)
+	return p
+}
+
+// RemoveTags removes tags in a locale
+func (p *PostPatchBuilder) RemoveTags(locale string) *PostPatchBuilder {
+	p.add("remove", "tags", locale, "", nil)
+	return p
+}
+
+// SetFeaturedImage sets featuredImage in a locale
+func (p *PostPatchBuilder) SetFeaturedImage(locale string, v string) *PostPatchBuilder {
+	p.add("add", "featuredImage", locale, "", AssetLink(v))
+	return p
+}
+
+// RemoveFeaturedImage removes featuredImage in a locale
+func (p *PostPatchBuilder) RemoveFeaturedImage(locale string) *PostPatchBuilder {
+	p.add("remove", "featuredImage", locale, "", nil)
+	return p
+}
+
+// SetDate sets date in a locale
+func (p *PostPatchBuilder) SetDate(locale string, v Date) *PostPatchBuilder {
+	p.add("add", "date", locale, "", v)
+	return p
+}
+
+// RemoveDate removes date in a locale
+func (p *PostPatchBuilder) RemoveDate(locale string) *PostPatchBuilder {
+	p.add("remove", "date", locale, "", nil)
+	return p
+}
+
+// SetComments sets comments in a locale
+func (p *PostPatchBuilder) SetComments(locale string, v bool) *PostPatchBuilder {
+	p.add("add", "comments", locale, "", v)
+	return p
+}
+
+// RemoveComments removes comments in a locale
+func (p *PostPatchBuilder) RemoveComments(locale string) *PostPatchBuilder {
+	p.add("remove", "comments", locale, "", nil)
+	return p
+}
+
+// SetApprover sets approver in a locale
+func (p *PostPatchBuilder) SetApprover(locale string, v string) *PostPatchBuilder {
+	p.add("add", "approver", locale, "", EntryLink(v))
+	return p
+}
+
+// RemoveApprover removes approver in a locale
+func (p *PostPatchBuilder) RemoveApprover(locale string) *PostPatchBuilder {
+	p.add("remove", "approver", locale, "", nil)
+	return p
+}
+
+// SetAuthorOrPost replaces all values of authorOrPost in a locale
+func (p *PostPatchBuilder) SetAuthorOrPost(locale string, vs ...string) *PostPatchBuilder {
+	values := make([]interface{}, len(vs))
+	for i, v := range vs {
+		values[i] = EntryLink(v)
+	}
+	p.add("add", "authorOrPost", locale, "", values)
+	return p
+}
+
+// AddAuthorOrPost appends a value to authorOrPost in a locale
+func (p *PostPatchBuilder) AddAuthorOrPost(locale string, v string) *PostPatchBuilder {
+	p.add("add", "authorOrPost", locale, "/-", EntryLink(v))
+	return p
+}
+
+// RemoveAuthorOrPost removes authorOrPost in a locale
+func (p *PostPatchBuilder) RemoveAuthorOrPost(locale string) *PostPatchBuilder {
+	p.add("remove", "authorOrPost", locale, "", nil)
+	return p
+}
+
+// Patch applies the operations of p to a Post entry. It fails if the entry changed since e.Version. Fields missing from e.Fields are created by the patch, so e should hold the entry as returned by the api
+func (s *PostEntryService) Patch(e *PostEntry, p *PostPatchBuilder) error {
+	ops, err := p.operations(e.Fields)
+	if err != nil {
+		return err
+	}
+	header := versionHeader(e.Version)
+	header.Set("Content-Type", "application/json-patch+json")
+	return s.send("PATCH", "/entries/"+url.PathEscape(e.ID), header, e, ops)
+}
+
+// AuthorPatchBuilder builds JSON Patch updates of Author entries
+type AuthorPatchBuilder struct {
+	patchBuilder
+}
+
+// AuthorPatch returns an empty patch for Author entries
+func AuthorPatch() *AuthorPatchBuilder {
+	return &AuthorPatchBuilder{}
+}
+
+// SetName sets name in a locale
+func (p *AuthorPatchBuilder) SetName(locale string, v string) *AuthorPatchBuilder {
+	p.add("add", "name", locale, "", v)
+	return p
+}
+
+// RemoveName removes name in a locale
+func (p *AuthorPatchBuilder) RemoveName(locale string) *AuthorPatchBuilder {
+	p.add("remove", "name", locale, "", nil)
+	return p
+}
+
+// SetWebsite sets website in a locale
+func (p *AuthorPatchBuilder) SetWebsite(locale string, v string) *AuthorPatchBuilder {
+	p.add("add", "website", locale, "", v)
+	return p
+}
+
+// RemoveWebsite removes website in a locale
+func (p *AuthorPatchBuilder) RemoveWebsite(locale string) *AuthorPatchBuilder {
+	p.add("remove", "website", locale, "", nil)
+	return p
+}
+
+// SetProfilePhoto sets profilePhoto in a locale
+func (p *AuthorPatchBuilder) SetProfilePhoto(locale string, v string) *AuthorPatchBuilder {
+	p.add("add", "profilePhoto", locale, "", AssetLink(v))
+	return p
+}
+
+// RemoveProfilePhoto removes profilePhoto in a locale
+func (p *AuthorPatchBuilder) RemoveProfilePhoto(locale string) *AuthorPatchBuilder {
+	p.add("remove", "profilePhoto", locale, "", nil)
+	return p
+}
+
+// SetBiography sets biography in a locale
+func (p *AuthorPatchBuilder) SetBiography(locale string, v string) *AuthorPatchBuilder {
+	p.add("add", "biography", locale, "", v)
+	return p
+}
+
+// RemoveBiography removes biography in a locale
+func (p *AuthorPatchBuilder) RemoveBiography(locale string) *AuthorPatchBuilder {
+	p.add("remove", "biography", locale, "", nil)
+	return p
+}
+
+// SetCreatedEntries replaces all values of createdEntries in a locale
+func (p *AuthorPatchBuilder) SetCreatedEntries(locale string, vs ...string) *AuthorPatchBuilder {
+	values := make([]interface{}, len(vs))
+	for i, v := range vs {
+		values[i] = EntryLink(v)
+	}
+	p.add("add", "createdEntries", locale, "", values)
+	return p
+}
+
+// AddCreatedEntry appends a value to createdEntries in a locale
+func (p *AuthorPatchBuilder) AddCreatedEntry(locale string, v string) *AuthorPatchBuilder {
+	p.add("add", "createdEntries", locale, "/-", EntryLink(v))
+	return p
+}
+
+// RemoveCreatedEntries removes createdEntries in a locale
+func (p *AuthorPatchBuilder) RemoveCreatedEntries(locale string) *AuthorPatchBuilder {
+	p.add("remove", "createdEntries", locale, "", nil)
+	return p
+}
+
+// SetAge sets age in a locale
+func (p *AuthorPatchBuilder) SetAge(locale string, v int64) *AuthorPatchBuilder {
+	p.add("add", "age", locale, "", v)
+	return p
+}
+
+// RemoveAge removes age in a locale
+func (p *AuthorPatchBuilder) RemoveAge(locale string) *AuthorPatchBuilder {
+	p.add("remove", "age", locale, "", nil)
+	return p
+}
+
+// SetRating sets rating in a locale
+func (p *AuthorPatchBuilder) SetRating(locale string, v float64) *AuthorPatchBuilder {
+	p.add("add", "rating", locale, "", v)
+	return p
+}
+
+// RemoveRating removes rating in a locale
+func (p *AuthorPatchBuilder) RemoveRating(locale string) *AuthorPatchBuilder {
+	p.add("remove", "rating", locale, "", nil)
+	return p
+}
+
+// Patch applies the operations of p to a Author entry. It fails if the entry changed since e.Version. Fields missing from e.Fields are created by the patch, so e should hold the entry as returned by the api
+func (s *AuthorEntryService) Patch(e *AuthorEntry, p *AuthorPatchBuilder) error {
+	ops, err := p.operations(e.Fields)
+	if err != nil {
+		return err
+	}
+	header := versionHeader(e.Version)
+	header.Set("Content-Type", "application/json-patch+json")
+	return s.send("PATCH", "/entries/"+url.PathEscape(e.ID), header, e, ops)
+}
+
+// CategoryPatchBuilder builds JSON Patch updates of Category entries
+type CategoryPatchBuilder struct {
+	patchBuilder
+}
+
+// CategoryPatch returns an empty patch for Category entries
+func CategoryPatch() *CategoryPatchBuilder {
+	return &CategoryPatchBuilder{}
+}
+
+// SetTitle sets title in a locale
+func (p *CategoryPatchBuilder) SetTitle(locale string, v string) *CategoryPatchBuilder {
+	p.add("add", "title", locale, "", v)
+	return p
+}
+
+// RemoveTitle removes title in a locale
+func (p *CategoryPatchBuilder) RemoveTitle(locale string) *CategoryPatchBuilder {
+	p.add("remove", "title", locale, "", nil)
+	return p
+}
+
+// SetShortDescription sets shortDescription in a locale
+func (p *CategoryPatchBuilder) SetShortDescription(locale string, v string) *CategoryPatchBuilder {
+	p.add("add", "shortDescription", locale, "", v)
+	return p
+}
+
+// RemoveShortDescription removes shortDescription in a locale
+func (p *CategoryPatchBuilder) RemoveShortDescription(locale string) *CategoryPatchBuilder {
+	p.add("remove", "shortDescription", locale, "", nil)
+	return p
+}
+
+// SetIcon sets icon in a locale
+func (p *CategoryPatchBuilder) SetIcon(locale string, v string) *CategoryPatchBuilder {
+	p.add("add", "icon", locale, "", AssetLink(v))
+	return p
+}
+
+// RemoveIcon removes icon in a locale
+func (p *CategoryPatchBuilder) RemoveIcon(locale string) *CategoryPatchBuilder {
+	p.add("remove", "icon", locale, "", nil)
+	return p
+}
+
+// SetParent sets parent in a locale
+func (p *CategoryPatchBuilder) SetParent(locale string, v string) *CategoryPatchBuilder {
+	p.add("add", "parent", locale, "", EntryLink(v))
+	return p
+}
+
+// RemoveParent removes parent in a locale
+func (p *CategoryPatchBuilder) RemoveParent(locale string) *CategoryPatchBuilder {
+	p.add("remove", "parent", locale, "", nil)
+	return p
+}
+
+// Patch applies the operations of p to a Category entry. It fails if the entry changed since e.Version. Fields missing from e.Fields are created by the patch, so e should hold the entry as returned by the api
+func (s *CategoryEntryService) Patch(e *CategoryEntry, p *CategoryPatchBuilder) error {
+	ops, err := p.operations(e.Fields)
+	if err != nil {
+		return err
+	}
+	header := versionHeader(e.Version)
+	header.Set("Content-Type", "application/json-patch+json")
+	return s.send("PATCH", "/entries/"+url.PathEscape(e.ID), header, e, ops)
+}
+
+// ErrProcessingTimeout is returned when asset files are not processed in time
+var ErrProcessingTimeout = errors.New("asset processing timed out")
+
+// assetPollInterval is the delay between checks of WaitProcessed
+const assetPollInterval = time.Second
+
+// Upload is a file uploaded to the upload api which can be attached to assets until it expires
+type Upload struct {
+	ID        string
+	ExpiresAt time.Time
+}
+type uploadItem struct {
+	Sys struct {
+		ID        string    `json:"id"`
+		ExpiresAt time.Time `json:"expiresAt"`
+	} `json:"sys"`
+}
+
+// UploadLink returns a link to the upload with the given ID
+func UploadLink(id string) Link {
+	return Link{Sys: LinkSys{
+		ID:       id,
+		LinkType: "Upload",
+		Type:     "Link",
+	}}
+}
+
+// AssetFile is a single locale file of an asset. URL is set once the file is processed
+type AssetFile struct {
+	FileName    string `json:"fileName"`
+	ContentType string `json:"contentType"`
+	Upload      string `json:"upload,omitempty"`
+	UploadFrom  *Link  `json:"uploadFrom,omitempty"`
+	URL         string `json:"url,omitempty"`
+}
+
+// UploadedFile returns an asset file created from an upload
+func UploadedFile(uploadID, fileName, contentType string) AssetFile {
+	link := UploadLink(uploadID)
+	return AssetFile{
+		ContentType: contentType,
+		FileName:    fileName,
+		UploadFrom:  &link,
+	}
+}
+
+// RemoteFile returns an asset file which contentful fetches from url during processing
+func RemoteFile(url, fileName, contentType string) AssetFile {
+	return AssetFile{
+		ContentType: contentType,
+		FileName:    fileName,
+		Upload:      url,
+	}
+}
+
+// ManagedAssetFields holds the asset fields in the management api format, mapping locale codes to values
+type ManagedAssetFields struct {
+	Title       map[string]string    `json:"title,omitempty"`
+	Description map[string]string    `json:"description,omitempty"`
+	File        map[string]AssetFile `json:"file,omitempty"`
+}
+
+// ManagedAsset is an asset of the management api
+type ManagedAsset struct {
+	ID      string             `json:"-"`
+	Version int                `json:"-"`
+	Fields  ManagedAssetFields `json:"fields"`
+}
+type managedAssetItem struct {
+	Sys    sys                `json:"sys"`
+	Fields ManagedAssetFields `json:"fields"`
+}
+
+// processed reports whether the files of the given locales have been processed. All files are checked if no locale is given
+func (a *ManagedAsset) processed(locales []string) bool {
+	if len(locales) == 0 {
+		for locale := range a.Fields.File {
+			locales = append(locales, locale)
+		}
+	}
+	for _, locale := range locales {
+		if a.Fields.File[locale].URL == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// AssetService includes asset and upload management functions
+type AssetService struct {
+	client *ManagementClient
+}
+
+// Assets returns an asset management service
+func (c *ManagementClient) Assets() *AssetService {
+	return &AssetService{client: c}
+}
+
+// Upload sends the content of r to the upload api. Readers of unknown length, like files, are streamed using chunked transfer encoding
+func (s *AssetService) Upload(r io.Reader) (*Upload, error) {
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/spaces/%s/uploads", s.client.uploadHost, s.client.spaceID), r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", s.client.authToken))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	resp, err := s.client.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, responseError(resp)
+	}
+	var data uploadItem
+	if err := decodeResponse(resp, nil, &data); err != nil {
+		return nil, err
+	}
+	return &Upload{
+		ExpiresAt: data.Sys.ExpiresAt,
+		ID:        data.Sys.ID,
+	}, nil
+}
+
+// send executes an asset request with an optional payload and updates a from the response
+func (s *AssetService) send(method, path string, header http.Header, a *ManagedAsset, in interface{}) error {
+	var out managedAssetItem
+	if err := s.client.do(method, path, header, in, &out); err != nil {
+		return err
+	}
+	a.ID = out.Sys.ID
+	a.Version = out.Sys.Version
+	a.Fields = out.Fields
+	return nil
+}
+
+// Get retrieves a single asset by its ID
+func (s *AssetService) Get(id string) (*ManagedAsset, error) {
+	a := &ManagedAsset{ID: id}
+	if err := s.send("GET", "/assets/"+url.PathEscape(a.ID), nil, a, nil); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Create adds a new asset. The asset is created with the given ID if set. Its files have to be processed before publishing
+func (s *AssetService) Create(a *ManagedAsset) error {
+	if a.ID == "" {
+		return s.send("POST", "/assets", nil, a, managedAssetItem{Fields: a.Fields})
+	}
+	return s.send("PUT", "/assets/"+url.PathEscape(a.ID), nil, a, managedAssetItem{Fields: a.Fields})
+}
+
+// Update replaces the fields of an existing asset. It fails if the asset changed since a.Version
+func (s *AssetService) Update(a *ManagedAsset) error {
+	return s.send("PUT", "/assets/"+url.PathEscape(a.ID), versionHeader(a.Version), a, managedAssetItem{Fields: a.Fields})
+}
+
+// UpdateWithRetry applies fn to the latest version of an asset and updates it, retrying with a fresh version on conflicts
+func (s *AssetService) UpdateWithRetry(id string, fn func(*ManagedAsset) error) (*ManagedAsset, error) {
+	return updateWithRetry(func() (*ManagedAsset, error) {
+		return s.Get(id)
+	}, s.Update, fn)
+}
+
+// Delete removes an unpublished asset
+func (s *AssetService) Delete(id string) error {
+	return s.client.do("DELETE", "/assets/"+url.PathEscape(id), nil, nil, nil)
+}
+
+// Publish makes the current version of an asset available in the delivery api
+func (s *AssetService) Publish(a *ManagedAsset) error {
+	return s.send("PUT", "/assets/"+url.PathEscape(a.ID)+"/published", versionHeader(a.Version), a, nil)
+}
+
+// Unpublish removes an asset from the delivery api
+func (s *AssetService) Unpublish(a *ManagedAsset) error {
+	return s.send("DELETE", "/assets/"+url.PathEscape(a.ID)+"/published", versionHeader(a.Version), a, nil)
+}
+
+// Archive archives an unpublished asset
+func (s *AssetService) Archive(a *ManagedAsset) error {
+	return s.send("PUT", "/assets/"+url.PathEscape(a.ID)+"/archived", versionHeader(a.Version), a, nil)
+}
+
+// Unarchive restores an archived asset
+func (s *AssetService) Unarchive(a *ManagedAsset) error {
+	return s.send("DELETE", "/assets/"+url.PathEscape(a.ID)+"/archived", versionHeader(a.Version), a, nil)
+}
+
+// Process starts processing the files of the given locales, or of all locales if none is given. Processing finishes asynchronously, see WaitProcessed. Each request bumps the asset version, a is refetched after every locale to send the current one
+func (s *AssetService) Process(a *ManagedAsset, locales ...string) error {
+	if len(locales) == 0 {
+		for locale := range a.Fields.File {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+	}
+	for _, locale := range locales {
+		if err := s.client.do("PUT", "/assets/"+url.PathEscape(a.ID)+"/files/"+url.PathEscape(locale)+"/process", versionHeader(a.Version), nil, nil); err != nil {
+			return err
+		}
+		if err := s.send("GET", "/assets/"+url.PathEscape(a.ID), nil, a, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitProcessed polls an asset until the files of the given locales, or of all locales if none is given, are processed. ErrProcessingTimeout is returned once timeout is exceeded
+func (s *AssetService) WaitProcessed(id string, timeout time.Duration, locales ...string) (*ManagedAsset, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		a, err := s.Get(id)
+		if err != nil {
+			return nil, err
+		}
+		if a.processed(locales) {
+			return a, nil
+		}
+		if time.Now().Add(assetPollInterval).After(deadline) {
+			return nil, ErrProcessingTimeout
+		}
+		time.Sleep(assetPollInterval)
+	}
+}
+
+// ManagedAssetIterator is used to paginate assets of the management api
+type ManagedAssetIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*ManagedAsset
+}
+
+// setPage records a fetched page in the pagination state
+func (it *ManagedAssetIterator) setPage(items []*ManagedAsset, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type ManagedAsset. If none exists a network request will be executed
+func (it *ManagedAssetIterator) Next() (*ManagedAsset, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	var item *ManagedAsset
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
+}
+
+// Page returns the remaining items of the current page of ManagedAsset. If none exists a network request will be executed
+func (it *ManagedAssetIterator) Page() ([]*ManagedAsset, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
+	}
+	items := it.items
+	it.items = nil
+	return items, nil
+}
+
+// All returns a range-over-func sequence of all remaining ManagedAsset items. Iteration stops after the first error
+func (it *ManagedAssetIterator) All() iter.Seq2[*ManagedAsset, error] {
+	return func(yield func(*ManagedAsset, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
 			}
-			var tmp = &Category{
-				ID:               entry.Sys.ID,
-				ShortDescription: item.Fields.ShortDescription,
-				Title:            item.Fields.Title,
+			if !yield(item, err) || err != nil {
+				return
 			}
-			cache.categorys[entry.Sys.ID] = tmp
-			tmp.Icon = resolveAsset(item.Fields.Icon.Sys.ID, includes)
-			tmp.Parent = resolveCategoryPtr(item.Fields.Parent.Sys.ID, items, includes, cache)
-			return *tmp
 		}
 	}
-	return Category{}
-}
-func resolveCategoryPtr(entryID string, items []includeEntry, includes includes, cache *iteratorCache) *Category {
-	var item = resolveCategory(entryID, items, includes, cache)
-	return &item
 }
-func resolveCategorysPtr(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []*Category {
-	var items = resolveCategorys(ids, its, includes, cache)
-	var ptrs []*Category
-	for i := range items {
-		ptrs = append(ptrs, &items[i])
+
+// Collect returns up to max remaining ManagedAsset items. A max of zero or less collects all items
+func (it *ManagedAssetIterator) Collect(max int) ([]*ManagedAsset, error) {
+	var items []*ManagedAsset
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
 	}
-	return ptrs
+	return items, nil
 }
-func resolveCategorys(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []Category {
-	var items []Category
-	entries := append(includes.Entries, its...)
-	for _, entryID := range ids {
-		var item categoryItem
-		var entry *includeEntry
-		for _, e := range entries {
-			if e.Sys.ID == entryID.Sys.ID {
-				entry = &e
-				break
-			}
-		}
-		if entry == nil {
-			continue
+
+// ForEach calls fn for every remaining ManagedAsset item and stops at the first error
+func (it *ManagedAssetIterator) ForEach(fn func(*ManagedAsset) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
 		}
-		if v, ok := cache.categorys[entry.Sys.ID]; ok {
-			items = append(items, *v)
-			continue
+		if err := fn(item); err != nil {
+			return err
 		}
-		if err := json.Unmarshal(*entry.Fields, &item.Fields); err != nil {
-			return items
+	}
+	return nil
+}
+
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *ManagedAssetIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
 		}
-		var tmp = &Category{
-			ID:               entry.Sys.ID,
-			ShortDescription: item.Fields.ShortDescription,
-			Title:            item.Fields.Title,
+	}
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type ManagedAsset
+func (it *ManagedAssetIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *ManagedAssetIterator) fetch() error {
+	var data struct {
+		Total int                `json:"total"`
+		Items []managedAssetItem `json:"items"`
+	}
+	if err := it.c.do("GET", fmt.Sprintf("/assets?limit=%d&skip=%d&order=%s", it.Limit, it.Offset, defaultOrder), nil, nil, &data); err != nil {
+		return err
+	}
+	items := make([]*ManagedAsset, len(data.Items))
+	for i, raw := range data.Items {
+		items[i] = &ManagedAsset{
+			Fields:  raw.Fields,
+			ID:      raw.Sys.ID,
+			Version: raw.Sys.Version,
 		}
-		cache.categorys[entry.Sys.ID] = tmp
-		tmp.Icon = resolveAsset(item.Fields.Icon.Sys.ID, includes)
-		tmp.Parent = resolveCategoryPtr(item.Fields.Parent.Sys.ID, its, includes, cache)
-		items = append(items, *tmp)
 	}
-	return items
+	it.setPage(items, data.Total)
+	return nil
 }
 
-// Categories retrieves paginated Category entries
-func (c *ContentClient) Categories(opts ListOptions) *CategoryIterator {
+// List retrieves paginated assets including drafts
+func (s *AssetService) List(opts ListOptions) *ManagedAssetIterator {
 	if opts.Limit <= 0 {
 		opts.Limit = 100
 	}
-	it := &CategoryIterator{
-		IncludeCount: opts.IncludeCount,
-		Limit:        opts.Limit,
-		Page:         opts.Page,
-		c:            c,
-		lookupCache: &iteratorCache{
-			authors:   make(map[string]*Author),
-			categorys: make(map[string]*Category),
-			posts:     make(map[string]*Post),
-		},
+	return &ManagedAssetIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      s.client,
 	}
-	return it
 }
 
-// ErrIteratorDone is used to indicate that the iterator has no more data
-var ErrIteratorDone = fmt.Errorf("IteratorDone")
-
-// ListOptions contains pagination configuration for iterators
-type ListOptions struct {
-	Page         int
-	Limit        int
-	IncludeCount int
+// Validation restricts the values of a field. Validations without a dedicated field are kept in Other as raw json
+type Validation struct {
+	LinkContentType []string
+	Unique          bool
+	Other           map[string]json.RawMessage
 }
 
-func resolveAsset(assetID string, includes includes) Asset {
-	for _, asset := range includes.Assets {
-		if asset.Sys.ID == assetID {
-			return Asset{
-				Height: asset.Fields.File.Details.Image.Height,
-				Size:   0,
-				URL:    fmt.Sprintf("https:%s", asset.Fields.File.URL),
-				Width:  asset.Fields.File.Details.Image.Width,
-			}
-		}
+// UnmarshalJSON decodes a validation, keeping unknown validations in Other
+func (v *Validation) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
 	}
-	return Asset{}
-}
-func resolveEntries(ids entryIDs, its []includeEntry, includes includes, cache *iteratorCache) []interface{} {
-	var items []interface{}
-	for _, entry := range includes.Entries {
-		var included = false
-		for _, entryID := range ids {
-			included = included || entryID.Sys.ID == entry.Sys.ID
-		}
-		if included == true {
-			if entry.Sys.ContentType.Sys.ID == "2wKn6yEnZewu2SCCkus4as" {
-				items = append(items, resolvePost(entry.Sys.ID, its, includes, cache))
-			}
-			if entry.Sys.ContentType.Sys.ID == "1kUEViTN4EmGiEaaeC6ouY" {
-				items = append(items, resolveAuthor(entry.Sys.ID, its, includes, cache))
-			}
-			if entry.Sys.ContentType.Sys.ID == "5KMiN6YPvi42icqAUQMCQe" {
-				items = append(items, resolveCategory(entry.Sys.ID, its, includes, cache))
+	*v = Validation{}
+	for k, value := range raw {
+		var err error
+		switch k {
+		case "linkContentType":
+			err = json.Unmarshal(value, &v.LinkContentType)
+		case "unique":
+			err = json.Unmarshal(value, &v.Unique)
+		default:
+			if v.Other == nil {
+				v.Other = map[string]json.RawMessage{}
 			}
+			v.Other[k] = value
 		}
-	}
-	return items
-}
-func resolveEntry(id entryID, its []includeEntry, includes includes, cache *iteratorCache) interface{} {
-	for _, entry := range includes.Entries {
-		if entry.Sys.ID == id.Sys.ID {
-			if entry.Sys.ContentType.Sys.ID == "2wKn6yEnZewu2SCCkus4as" {
-				return resolvePost(entry.Sys.ID, its, includes, cache)
-			}
-			if entry.Sys.ContentType.Sys.ID == "1kUEViTN4EmGiEaaeC6ouY" {
-				return resolveAuthor(entry.Sys.ID, its, includes, cache)
-			}
-			if entry.Sys.ContentType.Sys.ID == "5KMiN6YPvi42icqAUQMCQe" {
-				return resolveCategory(entry.Sys.ID, its, includes, cache)
-			}
+		if err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-// ContentClient implements a space specific contentful client
-type ContentClient struct {
-	host      string
-	spaceID   string
-	authToken string
-	Locale    string
-	client    *http.Client
-	pool      *x509.CertPool
+// MarshalJSON encodes a validation including the validations in Other
+func (v Validation) MarshalJSON() ([]byte, error) {
+	out := make(map[string]interface{}, len(v.Other)+2)
+	for k, value := range v.Other {
+		out[k] = value
+	}
+	if len(v.LinkContentType) > 0 {
+		out["linkContentType"] = v.LinkContentType
+	}
+	if v.Unique {
+		out["unique"] = true
+	}
+	return json.Marshal(out)
 }
 
-// contentfulCDAURL points to the contentful delivery api endpoint
-const contentfulCDAURL = "cdn.contentful.com"
+// FieldItems describes the values of an Array field
+type FieldItems struct {
+	Type        string       `json:"type"`
+	LinkType    string       `json:"linkType,omitempty"`
+	Validations []Validation `json:"validations,omitempty"`
+}
 
-// contentfulCDAURL points to the contentful preview api endpoint
-const contentfulCPAURL = "preview.contentful.com"
+// Field describes a field of a content type
+type Field struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type"`
+	LinkType     string                 `json:"linkType,omitempty"`
+	Localized    bool                   `json:"localized"`
+	Required     bool                   `json:"required"`
+	Disabled     bool                   `json:"disabled"`
+	Omitted      bool                   `json:"omitted"`
+	Items        *FieldItems            `json:"items,omitempty"`
+	Validations  []Validation           `json:"validations,omitempty"`
+	DefaultValue map[string]interface{} `json:"defaultValue,omitempty"`
+}
 
-// contentfulCDAURL points to the contentful management api endpoint
-const contentfulCMAURL = "api.contentful.com"
+// ContentType describes a content model of the management api. Changes only affect the delivery api once published
+type ContentType struct {
+	ID           string  `json:"-"`
+	Version      int     `json:"-"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description,omitempty"`
+	DisplayField string  `json:"displayField,omitempty"`
+	Fields       []Field `json:"fields"`
+}
+type contentTypeItem struct {
+	Sys sys `json:"sys"`
+	ContentType
+}
 
-// NewCDA returns a contentful client interfacing with the content delivery api
-func NewCDA(authToken string, locale string) *ContentClient {
-	pool := x509.NewCertPool()
-	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
-	return &ContentClient{
-		Locale:    locale,
-		authToken: authToken,
-		client:    &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
-		host:      fmt.Sprintf("https://%s", contentfulCDAURL),
-		pool:      pool,
-		spaceID:   "ygx37epqlss8",
-	}
+// ContentTypeService includes content type management functions
+type ContentTypeService struct {
+	client *ManagementClient
 }
 
-// NewCPA returns a contentful client interfacing with the content preview api
-func NewCPA(authToken string, locale string) *ContentClient {
-	pool := x509.NewCertPool()
-	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
-	return &ContentClient{
-		Locale:    locale,
-		authToken: authToken,
-		client:    &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
-		host:      fmt.Sprintf("https://%s", contentfulCPAURL),
-		pool:      pool,
-		spaceID:   "ygx37epqlss8",
+// ContentTypes returns a content type management service
+func (c *ManagementClient) ContentTypes() *ContentTypeService {
+	return &ContentTypeService{client: c}
+}
+
+// send executes a content type request with an optional payload and updates ct from the response
+func (s *ContentTypeService) send(method, path string, header http.Header, ct *ContentType, in interface{}) error {
+	var out contentTypeItem
+	if err := s.client.do(method, path, header, in, &out); err != nil {
+		return err
 	}
+	*ct = out.ContentType
+	ct.ID = out.Sys.ID
+	ct.Version = out.Sys.Version
+	return nil
 }
 
-// ManagementClient implements a space specific contentful client
-type ManagementClient struct {
-	host      string
-	spaceID   string
-	authToken string
-	client    *http.Client
-	pool      *x509.CertPool
+// Get retrieves the current draft of a content type by its ID
+func (s *ContentTypeService) Get(id string) (*ContentType, error) {
+	ct := &ContentType{ID: id}
+	if err := s.send("GET", "/content_types/"+url.PathEscape(ct.ID), nil, ct, nil); err != nil {
+		return nil, err
+	}
+	return ct, nil
 }
 
-// NewManagement returns a contentful client interfacing with the content management api
-func NewManagement(authToken string) *ManagementClient {
-	pool := x509.NewCertPool()
-	pool.AppendCertsFromPEM([]byte("-----BEGIN CERTIFICATE-----\nMIIL6TCCCtGgAwIBAgIQBigdNnW0H8yz/xj67Pj93zANBgkqhkiG9w0BAQsFADBw\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMS8wLQYDVQQDEyZEaWdpQ2VydCBTSEEyIEhpZ2ggQXNz\ndXJhbmNlIFNlcnZlciBDQTAeFw0xNDEyMDgwMDAwMDBaFw0xODAyMDYxMjAwMDBa\nMGwxCzAJBgNVBAYTAlVTMRMwEQYDVQQIEwpDYWxpZm9ybmlhMRYwFAYDVQQHEw1T\nYW4gRnJhbmNpc2NvMRUwEwYDVQQKEwxGYXN0bHksIEluYy4xGTAXBgNVBAMTEGEu\nc3NsLmZhc3RseS5uZXQwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQDU\nJUiQsaVP/vC4Mb3aJUmA9KnMQa7EJfjYLsE4F0VehrOp8jlSSXmQLELlUAwPp2F2\nPNyB32DDOFBHZIYwFrApFEzsJdTKQUYk6xHPZOdYoIijpmfb5xRMdTjqxThGkk+k\nhU0+ipPWiErJNRkapLgPwPD4ctd5X8rnKF8lMHIxx5Xhdg6PqZC3F7y45Nym2a3M\n8xIKIkB77o1bkuDpGnV9ZESC/Yf9Mc4NmWrQjqQc+8yIabir+n7/YcM5UdUjZPNS\nhgL4jLYVJ+KDRZcjIT/dXRZoPpJgRFL9NIep/eSAzQa3g659uW7tjN6tg5iQm4hw\nksaWp+zfTAJc4IXNtlndAgMBAAGjggiBMIIIfTAfBgNVHSMEGDAWgBRRaP+QrwIH\ndTzM2WVkYqISuFlyOzAdBgNVHQ4EFgQUwIj0Y03ka1Q28RLCtKWy4nN7FIgwggax\nBgNVHREEggaoMIIGpIIQYS5zc2wuZmFzdGx5Lm5ldIISKi5hLnNzbC5mYXN0bHku\nbmV0gg9mYXN0Lndpc3RpYS5jb22CEHB1cmdlLmZhc3RseS5uZXSCEm1pcnJvcnMu\nZmFzdGx5Lm5ldIIOKi5wYXJzZWNkbi5jb22CDSouZmFzdHNzbC5uZXSCCXZveGVy\nLmNvbYINd3d3LnZveGVyLmNvbYIOKi5maXJlYmFzZS5jb22CEHNpdGVzLnlhbW1l\nci5jb22CGHNpdGVzLnN0YWdpbmcueWFtbWVyLmNvbYIPKi5za2ltbGlua3MuY29t\nghMqLnNraW1yZXNvdXJjZXMuY29tghBjZG4udGhpbmdsaW5rLm1lggwqLmZpdGJp\ndC5jb22CEiouaG9zdHMuZmFzdGx5Lm5ldIISY29udHJvbC5mYXN0bHkubmV0gg8q\nLndpa2lhLWluYy5jb22CFSoucGVyZmVjdGF1ZGllbmNlLmNvbYILKi53aWtpYS5j\nb22CEmYuY2xvdWQuZ2l0aHViLmNvbYIVKi5kaWdpdGFsc2Npcm9jY28ubmV0ggoq\nLmV0c3kuY29tghAqLmV0c3lzdGF0aWMuY29tgg0qLmFkZHRoaXMuY29tghAqLmFk\nZHRoaXNjZG4uY29tgg9mYXN0Lndpc3RpYS5uZXSCDnJhdy5naXRodWIuY29tgg93\nd3cudXNlcmZveC5jb22CEyouYXNzZXRzLXlhbW1lci5jb22CGyouc3RhZ2luZy5h\nc3NldHMteWFtbWVyLmNvbYIWYXNzZXRzLmh1Z2dpZXMtY2RuLm5ldIISb3JiaXQu\nc2hhemFtaWQuY29tgg9hYm91dC5qc3Rvci5vcmeCFyouZ2xvYmFsLnNzbC5mYXN0\nbHkubmV0gg13ZWIudm94ZXIuY29tgg9weXBpLnB5dGhvbi5vcmeCCyouMTJ3YnQu\nY29tghJ3d3cuaG9sZGVyZGVvcmQubm+CGnNlY3VyZWQuaW5kbi5pbmZvbGlua3Mu\nY29tghBwbGF5LnZpZHlhcmQuY29tghhwbGF5LXN0YWdpbmcudmlkeWFyZC5jb22C\nFXNlY3VyZS5pbWcud2ZyY2RuLmNvbYIWc2VjdXJlLmltZy5qb3NzY2RuLmNvbYIQ\nKi5nb2NhcmRsZXNzLmNvbYIVd2lkZ2V0cy5waW50ZXJlc3QuY29tgg4qLjdkaWdp\ndGFsLmNvbYINKi43c3RhdGljLmNvbYIPcC5kYXRhZG9naHEuY29tghBuZXcubXVs\nYmVycnkuY29tghJ3d3cuc2FmYXJpZmxvdy5jb22CEmNkbi5jb250ZW50ZnVsLmNv\nbYIQdG9vbHMuZmFzdGx5Lm5ldIISKi5odWV2b3NidWVub3MuY29tgg4qLmdvb2Rl\nZ2dzLmNvbYIWKi5mYXN0bHkucGljbW9ua2V5LmNvbYIVKi5jZG4ud2hpcHBsZWhp\nbGwubmV0ghEqLndoaXBwbGVoaWxsLm5ldIIbY2RuLm1lZGlhMzQud2hpcHBsZWhp\nbGwubmV0ghtjZG4ubWVkaWE1Ni53aGlwcGxlaGlsbC5uZXSCG2Nkbi5tZWRpYTc4\nLndoaXBwbGVoaWxsLm5ldIIcY2RuLm1lZGlhOTEwLndoaXBwbGVoaWxsLm5ldIIO\nKi5tb2RjbG90aC5jb22CDyouZGlzcXVzY2RuLmNvbYILKi5qc3Rvci5vcmeCDyou\nZHJlYW1ob3N0LmNvbYIOd3d3LmZsaW50by5jb22CDyouY2hhcnRiZWF0LmNvbYIN\nKi5oaXBtdW5rLmNvbYIaY29udGVudC5iZWF2ZXJicm9va3MuY28udWuCG3NlY3Vy\nZS5jb21tb24uY3Nuc3RvcmVzLmNvbYIOd3d3LmpvaW5vcy5jb22CJXN0YWdpbmct\nbW9iaWxlLWNvbGxlY3Rvci5uZXdyZWxpYy5jb22CDioubW9kY2xvdGgubmV0ghAq\nLmZvdXJzcXVhcmUuY29tggwqLnNoYXphbS5jb22CCiouNHNxaS5uZXSCDioubWV0\nYWNwYW4ub3JnggwqLmZhc3RseS5jb22CCXdpa2lhLmNvbYIKZmFzdGx5LmNvbYIR\nKi5nYWR2ZW50dXJlcy5jb22CFnd3dy5nYWR2ZW50dXJlcy5jb20uYXWCFXd3dy5n\nYWR2ZW50dXJlcy5jby51a4IJa3JlZG8uY29tghZjZG4tdGFncy5icmFpbmllbnQu\nY29tghRteS5iaWxsc3ByaW5nYXBwLmNvbYIGcnZtLmlvMA4GA1UdDwEB/wQEAwIF\noDAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwdQYDVR0fBG4wbDA0oDKg\nMIYuaHR0cDovL2NybDMuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVyLWc1LmNy\nbDA0oDKgMIYuaHR0cDovL2NybDQuZGlnaWNlcnQuY29tL3NoYTItaGEtc2VydmVy\nLWc1LmNybDBMBgNVHSAERTBDMDcGCWCGSAGG/WwBATAqMCgGCCsGAQUFBwIBFhxo\ndHRwczovL3d3dy5kaWdpY2VydC5jb20vQ1BTMAgGBmeBDAECAjCBgwYIKwYBBQUH\nAQEEdzB1MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2VydC5jb20wTQYI\nKwYBBQUHMAKGQWh0dHA6Ly9jYWNlcnRzLmRpZ2ljZXJ0LmNvbS9EaWdpQ2VydFNI\nQTJIaWdoQXNzdXJhbmNlU2VydmVyQ0EuY3J0MAwGA1UdEwEB/wQCMAAwDQYJKoZI\nhvcNAQELBQADggEBAKLWzbX7wSyjzE7BVMjLrHAaiz+WGSwrAPrQBJ29sqouu9gv\nI7i2Ie6eiRb4YLMouy6D+ZNZ+RM+Hkjv+PZFxCcDRmaWi+74ha5d8O155gRJRPZ0\nSy5SfD/8kqrJRfC+/D/KdQzOroD4sx6Qprs9lZ0IEn4CTf0YPNV+Cps37LsVyPJL\nfjDlGIM5K3B/vtZfn2f8buQ9QyKiN0bc67GdCjih9dSrkQNkxJiEOwqiSjYtkdFO\ndYpXF8d1rQKV7a6z2vJloDwilfXLLlUX7rA3qVu7r4EUfIsZgH7hgB4bbst7tx+7\nPgUEq2334kKPVFpsxgsj5++k4lh7tNlakXiBUtw=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEsTCCA5mgAwIBAgIQBOHnpNxc8vNtwCtCuF0VnzANBgkqhkiG9w0BAQsFADBs\nMQswCQYDVQQGEwJVUzEVMBMGA1UEChMMRGlnaUNlcnQgSW5jMRkwFwYDVQQLExB3\nd3cuZGlnaWNlcnQuY29tMSswKQYDVQQDEyJEaWdpQ2VydCBIaWdoIEFzc3VyYW5j\nZSBFViBSb290IENBMB4XDTEzMTAyMjEyMDAwMFoXDTI4MTAyMjEyMDAwMFowcDEL\nMAkGA1UEBhMCVVMxFTATBgNVBAoTDERpZ2lDZXJ0IEluYzEZMBcGA1UECxMQd3d3\nLmRpZ2ljZXJ0LmNvbTEvMC0GA1UEAxMmRGlnaUNlcnQgU0hBMiBIaWdoIEFzc3Vy\nYW5jZSBTZXJ2ZXIgQ0EwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQC2\n4C/CJAbIbQRf1+8KZAayfSImZRauQkCbztyfn3YHPsMwVYcZuU+UDlqUH1VWtMIC\nKq/QmO4LQNfE0DtyyBSe75CxEamu0si4QzrZCwvV1ZX1QK/IHe1NnF9Xt4ZQaJn1\nitrSxwUfqJfJ3KSxgoQtxq2lnMcZgqaFD15EWCo3j/018QsIJzJa9buLnqS9UdAn\n4t07QjOjBSjEuyjMmqwrIw14xnvmXnG3Sj4I+4G3FhahnSMSTeXXkgisdaScus0X\nsh5ENWV/UyU50RwKmmMbGZJ0aAo3wsJSSMs5WqK24V3B3aAguCGikyZvFEohQcft\nbZvySC/zA/WiaJJTL17jAgMBAAGjggFJMIIBRTASBgNVHRMBAf8ECDAGAQH/AgEA\nMA4GA1UdDwEB/wQEAwIBhjAdBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIw\nNAYIKwYBBQUHAQEEKDAmMCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5kaWdpY2Vy\ndC5jb20wSwYDVR0fBEQwQjBAoD6gPIY6aHR0cDovL2NybDQuZGlnaWNlcnQuY29t\nL0RpZ2lDZXJ0SGlnaEFzc3VyYW5jZUVWUm9vdENBLmNybDA9BgNVHSAENjA0MDIG\nBFUdIAAwKjAoBggrBgEFBQcCARYcaHR0cHM6Ly93d3cuZGlnaWNlcnQuY29tL0NQ\nUzAdBgNVHQ4EFgQUUWj/kK8CB3U8zNllZGKiErhZcjswHwYDVR0jBBgwFoAUsT7D\naQP4v0cB1JgmGggC72NkK8MwDQYJKoZIhvcNAQELBQADggEBABiKlYkD5m3fXPwd\naOpKj4PWUS+Na0QWnqxj9dJubISZi6qBcYRb7TROsLd5kinMLYBq8I4g4Xmk/gNH\nE+r1hspZcX30BJZr01lYPf7TMSVcGDiEo+afgv2MW5gxTs14nhr9hctJqvIni5ly\n/D6q1UEL2tU2ob8cbkdJf17ZSHwD2f2LSaCYJkJA69aSEaRkCldUxPUd1gJea6zu\nxICaEnL6VpPX/78whQYwvwt/Tv9XBZ0k7YXDK/umdaisLRbvfXknsuvCnQsH6qqF\n0wGjIChBWUMo0oHjqvbsezt3tkBigAVBRQHvFwY+3sAzm2fTYS5yh+Rp/BIAV0Ae\ncPUeybQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFWTCCBEGgAwIBAgIRAI2kTzBRQqhJ3nOm/ZZpYacwDQYJKoZIhvcNAQELBQAw\ngZAxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMTYwNAYD\nVQQDEy1DT01PRE8gUlNBIERvbWFpbiBWYWxpZGF0aW9uIFNlY3VyZSBTZXJ2ZXIg\nQ0EwHhcNMTYwNjI3MDAwMDAwWhcNMTcwNzI3MjM1OTU5WjBeMSEwHwYDVQQLExhE\nb21haW4gQ29udHJvbCBWYWxpZGF0ZWQxHjAcBgNVBAsTFUVzc2VudGlhbFNTTCBX\naWxkY2FyZDEZMBcGA1UEAwwQKi5jb250ZW50ZnVsLmNvbTCCASIwDQYJKoZIhvcN\nAQEBBQADggEPADCCAQoCggEBALCfMS7doJgi6LkkMuNxGyurtC8Vcm0GtOcWZuf3\nCwauhbwQSHIVxJ8ggcnoNmVXXJN1hqctFUpapt2JLAuwUQUc/k6QJY8M06nWytJI\np3Lf6o3bkWMBxbbIGV6L1ybmtBnh2lRCIw1MSnD620tEAH1om2UIgIPPI/6fH4ZC\n8P7S4/2ImJ9EsbGUuYoBPIP2pIcNMP+lRaIGpPqyffyP46Tr0gAhPC8SOctfRCRe\n5DjPkWTFCIK/X7wux5VWEKhk+ZmpN/E/930ixwZynNqGr/7GVWh4Vvqc7GgNb1yO\nl3co4xwSbdseCYL2eWWDrisP+h7KygIGKpZ116wjUjjClk0CAwEAAaOCAd0wggHZ\nMB8GA1UdIwQYMBaAFJCvajqUWgvYkOoSVnPfQ7Q6KNrnMB0GA1UdDgQWBBRSLKYh\nJNKm4ApiHS0i4VLS8TvSDzAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAd\nBgNVHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwTwYDVR0gBEgwRjA6BgsrBgEE\nAbIxAQICBzArMCkGCCsGAQUFBwIBFh1odHRwczovL3NlY3VyZS5jb21vZG8uY29t\nL0NQUzAIBgZngQwBAgEwVAYDVR0fBE0wSzBJoEegRYZDaHR0cDovL2NybC5jb21v\nZG9jYS5jb20vQ09NT0RPUlNBRG9tYWluVmFsaWRhdGlvblNlY3VyZVNlcnZlckNB\nLmNybDCBhQYIKwYBBQUHAQEEeTB3ME8GCCsGAQUFBzAChkNodHRwOi8vY3J0LmNv\nbW9kb2NhLmNvbS9DT01PRE9SU0FEb21haW5WYWxpZGF0aW9uU2VjdXJlU2VydmVy\nQ0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21vZG9jYS5jb20wKwYD\nVR0RBCQwIoIQKi5jb250ZW50ZnVsLmNvbYIOY29udGVudGZ1bC5jb20wDQYJKoZI\nhvcNAQELBQADggEBAGjTyCxabJc8vs4P2ayuF2/k8pr3oISpo8+bF4QFtXCQhr6I\n2G6OYvzZLWXCVFJ53FdT7PDIchP4tlYafySgXKo8POxfS20jBKk6+ZYEzwVlgRd2\njyhojQTNlilj9hPq3CJd4WK3KmA9Hnd9cRkdsduDcFeENviUWw/hgq3PvoYgGshh\nz9DzW878tMtAZk5DfiTkOvgphgvbaCod9W5MsDJ3NyQ6P88//28seyxs6yVTMKvM\nfsOz/kf3AgM+JbmAgpHZk8LkXI9qCIpjS9zcijRWz/QD8M/QedX5oKLB/PFBsP7k\n03x4tMWKes9Y3t+9Rdnx0kanOAkIzWLaZIh7igg=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIGCDCCA/CgAwIBAgIQKy5u6tl1NmwUim7bo3yMBzANBgkqhkiG9w0BAQwFADCB\nhTELMAkGA1UEBhMCR0IxGzAZBgNVBAgTEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4G\nA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMRQ09NT0RPIENBIExpbWl0ZWQxKzApBgNV\nBAMTIkNPTU9ETyBSU0EgQ2VydGlmaWNhdGlvbiBBdXRob3JpdHkwHhcNMTQwMjEy\nMDAwMDAwWhcNMjkwMjExMjM1OTU5WjCBkDELMAkGA1UEBhMCR0IxGzAZBgNVBAgT\nEkdyZWF0ZXIgTWFuY2hlc3RlcjEQMA4GA1UEBxMHU2FsZm9yZDEaMBgGA1UEChMR\nQ09NT0RPIENBIExpbWl0ZWQxNjA0BgNVBAMTLUNPTU9ETyBSU0EgRG9tYWluIFZh\nbGlkYXRpb24gU2VjdXJlIFNlcnZlciBDQTCCASIwDQYJKoZIhvcNAQEBBQADggEP\nADCCAQoCggEBAI7CAhnhoFmk6zg1jSz9AdDTScBkxwtiBUUWOqigwAwCfx3M28Sh\nbXcDow+G+eMGnD4LgYqbSRutA776S9uMIO3Vzl5ljj4Nr0zCsLdFXlIvNN5IJGS0\nQa4Al/e+Z96e0HqnU4A7fK31llVvl0cKfIWLIpeNs4TgllfQcBhglo/uLQeTnaG6\nytHNe+nEKpooIZFNb5JPJaXyejXdJtxGpdCsWTWM/06RQ1A/WZMebFEh7lgUq/51\nUHg+TLAchhP6a5i84DuUHoVS3AOTJBhuyydRReZw3iVDpA3hSqXttn7IzW3uLh0n\nc13cRTCAquOyQQuvvUSH2rnlG51/ruWFgqUCAwEAAaOCAWUwggFhMB8GA1UdIwQY\nMBaAFLuvfgI9+qbxPISOre44mOzZMjLUMB0GA1UdDgQWBBSQr2o6lFoL2JDqElZz\n30O0Oija5zAOBgNVHQ8BAf8EBAMCAYYwEgYDVR0TAQH/BAgwBgEB/wIBADAdBgNV\nHSUEFjAUBggrBgEFBQcDAQYIKwYBBQUHAwIwGwYDVR0gBBQwEjAGBgRVHSAAMAgG\nBmeBDAECATBMBgNVHR8ERTBDMEGgP6A9hjtodHRwOi8vY3JsLmNvbW9kb2NhLmNv\nbS9DT01PRE9SU0FDZXJ0aWZpY2F0aW9uQXV0aG9yaXR5LmNybDBxBggrBgEFBQcB\nAQRlMGMwOwYIKwYBBQUHMAKGL2h0dHA6Ly9jcnQuY29tb2RvY2EuY29tL0NPTU9E\nT1JTQUFkZFRydXN0Q0EuY3J0MCQGCCsGAQUFBzABhhhodHRwOi8vb2NzcC5jb21v\nZG9jYS5jb20wDQYJKoZIhvcNAQEMBQADggIBAE4rdk+SHGI2ibp3wScF9BzWRJ2p\nmj6q1WZmAT7qSeaiNbz69t2Vjpk1mA42GHWx3d1Qcnyu3HeIzg/3kCDKo2cuH1Z/\ne+FE6kKVxF0NAVBGFfKBiVlsit2M8RKhjTpCipj4SzR7JzsItG8kO3KdY3RYPBps\nP0/HEZrIqPW1N+8QRcZs2eBelSaz662jue5/DJpmNXMyYE7l3YphLG5SEXdoltMY\ndVEVABt0iN3hxzgEQyjpFv3ZBdRdRydg1vs4O2xyopT4Qhrf7W8GjEXCBgCq5Ojc\n2bXhc3js9iPc0d1sjhqPpepUfJa3w/5Vjo1JXvxku88+vZbrac2/4EjxYoIQ5QxG\nV/Iz2tDIY+3GH5QFlkoakdH368+PUq4NCNk+qKBR6cGHdNXJ93SrLlP7u3r7l+L4\nHyaPs9Kg4DdbKDsx5Q5XLVq4rXmsXiBmGqW5prU5wfWYQ//u+aen/e7KJD2AFsQX\nj4rBYKEMrltDR5FL1ZoXX/nUh8HCjLfn4g8wGTeGrODcQgPmlKidrv0PJFGUzpII\n0fxQ8ANAe4hZ7Q7drNJ3gjTcBpUC2JD5Leo31Rpg0Gcg19hCC0Wvgmje3WYkN5Ap\nlBlGGSW4gNfL1IYoakRwJiNiqZ+Gb7+6kHDSVneFeO/qJakXzlByjAA6quPbYzSf\n+AZxAeKCINT+b72x\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIFdDCCBFygAwIBAgIQJ2buVutJ846r13Ci/ITeIjANBgkqhkiG9w0BAQwFADBv\nMQswCQYDVQQGEwJTRTEUMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFk\nZFRydXN0IEV4dGVybmFsIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBF\neHRlcm5hbCBDQSBSb290MB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFow\ngYUxCzAJBgNVBAYTAkdCMRswGQYDVQQIExJHcmVhdGVyIE1hbmNoZXN0ZXIxEDAO\nBgNVBAcTB1NhbGZvcmQxGjAYBgNVBAoTEUNPTU9ETyBDQSBMaW1pdGVkMSswKQYD\nVQQDEyJDT01PRE8gUlNBIENlcnRpZmljYXRpb24gQXV0aG9yaXR5MIICIjANBgkq\nhkiG9w0BAQEFAAOCAg8AMIICCgKCAgEAkehUktIKVrGsDSTdxc9EZ3SZKzejfSNw\nAHG8U9/E+ioSj0t/EFa9n3Byt2F/yUsPF6c947AEYe7/EZfH9IY+Cvo+XPmT5jR6\n2RRr55yzhaCCenavcZDX7P0N+pxs+t+wgvQUfvm+xKYvT3+Zf7X8Z0NyvQwA1onr\nayzT7Y+YHBSrfuXjbvzYqOSSJNpDa2K4Vf3qwbxstovzDo2a5JtsaZn4eEgwRdWt\n4Q08RWD8MpZRJ7xnw8outmvqRsfHIKCxH2XeSAi6pE6p8oNGN4Tr6MyBSENnTnIq\nm1y9TBsoilwie7SrmNnu4FGDwwlGTm0+mfqVF9p8M1dBPI1R7Qu2XK8sYxrfV8g/\nvOldxJuvRZnio1oktLqpVj3Pb6r/SVi+8Kj/9Lit6Tf7urj0Czr56ENCHonYhMsT\n8dm74YlguIwoVqwUHZwK53Hrzw7dPamWoUi9PPevtQ0iTMARgexWO/bTouJbt7IE\nIlKVgJNp6I5MZfGRAy1wdALqi2cVKWlSArvX31BqVUa/oKMoYX9w0MOiqiwhqkfO\nKJwGRXa/ghgntNWutMtQ5mv0TIZxMOmm3xaG4Nj/QN370EKIf6MzOi5cHkERgWPO\nGHFrK+ymircxXDpqR+DDeVnWIBqv8mqYqnK8V0rSS527EPywTEHl7R09XiidnMy/\ns1Hap0flhFMCAwEAAaOB9DCB8TAfBgNVHSMEGDAWgBStvZh6NLQm9/rEJlTvA73g\nJMtUGjAdBgNVHQ4EFgQUu69+Aj36pvE8hI6t7jiY7NkyMtQwDgYDVR0PAQH/BAQD\nAgGGMA8GA1UdEwEB/wQFMAMBAf8wEQYDVR0gBAowCDAGBgRVHSAAMEQGA1UdHwQ9\nMDswOaA3oDWGM2h0dHA6Ly9jcmwudXNlcnRydXN0LmNvbS9BZGRUcnVzdEV4dGVy\nbmFsQ0FSb290LmNybDA1BggrBgEFBQcBAQQpMCcwJQYIKwYBBQUHMAGGGWh0dHA6\nLy9vY3NwLnVzZXJ0cnVzdC5jb20wDQYJKoZIhvcNAQEMBQADggEBAGS/g/FfmoXQ\nzbihKVcN6Fr30ek+8nYEbvFScLsePP9NDXRqzIGCJdPDoCpdTPW6i6FtxFQJdcfj\nJw5dhHk3QBN39bSsHNA7qxcS1u80GH4r6XnTq1dFDK8o+tDb5VCViLvfhVdpfZLY\nUspzgb8c8+a4bmYRBbMelC1/kZWSWfFMzqORcUx8Rww7Cxn2obFshj5cqsQugsv5\nB5a6SE2Q8pTIqXOi6wZ7I53eovNNVZ96YUWYGGjHXkBrI/V5eu+MtWuLt29G9Hvx\nPUsE2JOAWVrgQSQdso8VYFhH2+9uRv0V9dlfmrPb2LjkQLPNlzmuhbsdjrzch5vR\npu/xO28QOG8=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIENjCCAx6gAwIBAgIBATANBgkqhkiG9w0BAQUFADBvMQswCQYDVQQGEwJTRTEU\nMBIGA1UEChMLQWRkVHJ1c3QgQUIxJjAkBgNVBAsTHUFkZFRydXN0IEV4dGVybmFs\nIFRUUCBOZXR3b3JrMSIwIAYDVQQDExlBZGRUcnVzdCBFeHRlcm5hbCBDQSBSb290\nMB4XDTAwMDUzMDEwNDgzOFoXDTIwMDUzMDEwNDgzOFowbzELMAkGA1UEBhMCU0Ux\nFDASBgNVBAoTC0FkZFRydXN0IEFCMSYwJAYDVQQLEx1BZGRUcnVzdCBFeHRlcm5h\nbCBUVFAgTmV0d29yazEiMCAGA1UEAxMZQWRkVHJ1c3QgRXh0ZXJuYWwgQ0EgUm9v\ndDCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEBALf3GjPm8gAELTngTlvt\nH7xsD821+iO2zt6bETOXpClMfZOfvUq8k+0DGuOPz+VtUFrWlymUWoCwSXrbLpX9\nuMq/NzgtHj6RQa1wVsfwTz/oMp50ysiQVOnGXw94nZpAPA6sYapeFI+eh6FqUNzX\nmk6vBbOmcZSccbNQYArHE504B4YCqOmoaSYYkKtMsE8jqzpPhNjfzp/haW+710LX\na0Tkx63ubUFfclpxCDezeWWkWaCUN/cALw3CknLa0Dhy2xSoRcRdKn23tNbE7qzN\nE0S3ySvdQwAl+mG5aWpYIxG3pzOPVnVZ9c0p10a3CitlttNCbxWyuHv77+ldU9U0\nWicCAwEAAaOB3DCB2TAdBgNVHQ4EFgQUrb2YejS0Jvf6xCZU7wO94CTLVBowCwYD\nVR0PBAQDAgEGMA8GA1UdEwEB/wQFMAMBAf8wgZkGA1UdIwSBkTCBjoAUrb2YejS0\nJvf6xCZU7wO94CTLVBqhc6RxMG8xCzAJBgNVBAYTAlNFMRQwEgYDVQQKEwtBZGRU\ncnVzdCBBQjEmMCQGA1UECxMdQWRkVHJ1c3QgRXh0ZXJuYWwgVFRQIE5ldHdvcmsx\nIjAgBgNVBAMTGUFkZFRydXN0IEV4dGVybmFsIENBIFJvb3SCAQEwDQYJKoZIhvcN\nAQEFBQADggEBALCb4IUlwtYj4g+WBpKdQZic2YR5gdkeWxQHIzZlj7DYd7usQWxH\nYINRsPkyPef89iYTx4AWpb9a/IfPeHmJIZriTAcKhjW88t5RxNKWt9x+Tu5w/Rw5\n6wwCURQtjr0W4MHfRnXnJK3s9EK0hZNwEGe6nQY1ShjTK3rMUUKhemPR5ruhxSvC\nNr4TDea9Y355e6cJDUCrat2PisP29owaQgVR1EX1n6diIWgVIEM8med8vSTYqZEX\nc4g/VhsxOBi0cQ+azcgOno4uG+GMmIPLHzHxREzGBHNJdmAPx/i9F4BrLunMTA5a\nmnkPIAou1Z5jJh5VkpTYghdae9C8x49OhgQ=\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIKuzCCCaOgAwIBAgIMNjYXNLLXqLY/TOgcMA0GCSqGSIb3DQEBCwUAMFcxCzAJ\nBgNVBAYTAkJFMRkwFwYDVQQKExBHbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRH\nbG9iYWxTaWduIENsb3VkU1NMIENBIC0gU0hBMjU2IC0gRzMwHhcNMTcwMzIzMTEy\nMzE5WhcNMTcxMTAzMTEyMjIzWjBgMQswCQYDVQQGEwJVUzERMA8GA1UECBMIRGVs\nYXdhcmUxDjAMBgNVBAcTBURvdmVyMRYwFAYDVQQKEw1JbmNhcHN1bGEgSW5jMRYw\nFAYDVQQDEw1pbmNhcHN1bGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEA3sgLKGR+l4KI2c5rjhwTQcuXu32CXy2BuUqZkco4pDsHzvBWKNAzxqSe\nUOt4LmhBzLETdHqvsXVPnNW4mbruVbreTZoJ6//Jy4WfBAtvVq/uL9krmv19opt1\n0Ll4+LLpeI+VBnkCNBPrALk/7aoVHeZcTmRmq2QPbRIBSaa+ZQhL+swQc+ATmcxd\nz9ew1gZPo9kWC4lZ63FIRu97wa3yKWi1qD8alv1EKFgInZOLtp359BOJlLvTt4zC\nnAzvMZog8YXJ9Mit5mSWTBGfKp0Z4QF5GIQNcM2rPFZC3nWjwjp7DwgsCvpJDzHR\nkpULclhrBX7LvbvMdMzT2KgvvrwZtwIDAQABo4IHfDCCB3gwDgYDVR0PAQH/BAQD\nAgWgMIGKBggrBgEFBQcBAQR+MHwwQgYIKwYBBQUHMAKGNmh0dHA6Ly9zZWN1cmUu\nZ2xvYmFsc2lnbi5jb20vY2FjZXJ0L2Nsb3Vkc3Nsc2hhMmczLmNydDA2BggrBgEF\nBQcwAYYqaHR0cDovL29jc3AyLmdsb2JhbHNpZ24uY29tL2Nsb3Vkc3Nsc2hhMmcz\nMFYGA1UdIARPME0wQQYJKwYBBAGgMgEUMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8v\nd3d3Lmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMAgGBmeBDAECAjAJBgNVHRME\nAjAAMIIGFQYDVR0RBIIGDDCCBgiCDWluY2Fwc3VsYS5jb22CHSouYWNjZXB0YXRp\nZS1lbmdpZS1lbmVyZ2llLm5sgg0qLmFtd2FsYWsuY29tghsqLmFwcGx5LmdvbWFz\ndGVyY2FyZC5jb20uYXWCCyouYXZpdmEuY29tghEqLmF2aXZhY2FuYWRhLmNvbYIQ\nKi5iaW5nb21hbmlhLmNvbYIRKi5icmFuY2hldHZvdXMuZnKCEyouY2xlYXJza3kt\nZGF0YS5uZXSCDiouY29uZWN0eXMuY29tghAqLmNvbnRlbnRmdWwuY29tgg4qLmNv\ncmVmb3VyLmNvbYIMKi5jb3JzYWlyLmNpggwqLmNvcnNhaXIuZ3CCDCouY29yc2Fp\nci5tcYIMKi5jb3JzYWlyLnNughQqLmNyZWRpdG95Y2F1Y2lvbi5lc4IWKi5kYzQu\ncGFnZXVwcGVvcGxlLmNvbYIWKi5kZXZpY2Vwcm90ZWN0aW9uLmNvbYISKi5kaWFq\ndWdvc28xMjMuY29tghUqLmRpcmVjdG1vYmlsZXMuY28udWuCEyouZWRkaWVhbmRj\nby5jb20uYXWCFCouZWtlZXBlcmdyb3VwLmNvLnVrggwqLmVwaWR1by5jb22CESou\nZXBpZHVvZm9ydGUuY29tghUqLmV2b3F1YWFkdmFudGFnZS5jb22CFSouaGVkZ2Vz\ndG9uZWdyb3VwLmNvbYIUKi5pbmNhcHN1bGEtZGVtby5iaXqCDCouaXZyYXBwLm5l\ndIINKi5rcC1teXBnLmNvbYIYKi5sYi5uZXN0bGUtd2F0ZXJzbmEuY29tgg0qLmx1\nbHVjcm0uY29tghMqLm1hZGV3aXRobmVzdGxlLmNhgg4qLm1hcmljb3BhLmVkdYIU\nKi5tYXR0ZWxwYXJ0bmVycy5jb22CGioubXhwLnphbXNoLmluY2Fwc3VsYS5tb2Jp\ngg0qLm15ZXIuY29tLmF1gg0qLm15bnZhcHAuY29tgg8qLm5ldDJwaG9uZS5jb22C\nDSoucGluMTExMS5jb22CECoucHJvZC5pbG9hbi5jb22CGCouc2Nhbm5lci5zcG90\nb3B0aW9uLmNvbYIZKi5zZWFyY2hmbG93c3RhZ2luZy5jby51a4IXKi5zaW1wbHli\nZXR0ZXJ0aW4uY28udWuCCiouc29mbi5jb22CDiouc3RyYXR0b24uY29tghcqLnRl\nc3QtZW5naWUtZW5lcmdpZS5ubIIQKi50cmF2ZWxwb3J0LmNvbYIOKi50cmVtYmxh\nbnQuY2GCCyoudml0dGVsLmpwgg0qLnZ0ZWNoLmNvLnVrgg0qLndlcmFsbHkuY29t\nghcqLndoaXRlaG91c2VoaXN0b3J5Lm9yZ4IMKi53cnBzLm9uLmNhggkqLnd0ZS5u\nZXSCCyoueW91ZmkuY29tghthY2NlcHRhdGllLWVuZ2llLWVuZXJnaWUubmyCC2Ft\nd2FsYWsuY29tgglhdml2YS5jb22CDmJpbmdvbWFuaWEuY29tgg9icmFuY2hldHZv\ndXMuZnKCDGNvbmVjdHlzLmNvbYIKY29yc2Fpci5jaYIKY29yc2Fpci5ncIIKY29y\nc2Fpci5tcYIKY29yc2Fpci5zboIUZGV2aWNlcHJvdGVjdGlvbi5jb22CEGRpYWp1\nZ29zbzEyMy5jb22CE2RpcmVjdG1vYmlsZXMuY28udWuCEWVkZGllYW5kY28uY29t\nLmF1ggplcGlkdW8uY29tgg9lcGlkdW9mb3J0ZS5jb22CE2V2b3F1YWFkdmFudGFn\nZS5jb22CE2hlZGdlc3RvbmVncm91cC5jb22CC2twLW15cGcuY29tghFtYWRld2l0\naG5lc3RsZS5jYYINbmV0MnBob25lLmNvbYILcGluMTExMS5jb22CF3NlYXJjaGZs\nb3dzdGFnaW5nLmNvLnVrghVzaW1wbHliZXR0ZXJ0aW4uY28udWuCFXRlc3QtZW5n\naWUtZW5lcmdpZS5ubIIOdHJhdmVscG9ydC5jb22CC3Z0ZWNoLmNvLnVrggt3ZXJh\nbGx5LmNvbYIKd3Jwcy5vbi5jYYIHd3RlLm5ldDAdBgNVHSUEFjAUBggrBgEFBQcD\nAQYIKwYBBQUHAwIwHQYDVR0OBBYEFFAxG/f60PL3CFmmnW/Uk9gBtDhCMB8GA1Ud\nIwQYMBaAFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMA0GCSqGSIb3DQEBCwUAA4IBAQAn\n/+V5a6hfMgXZJa5H0c0Gu5E3bSl8gvQsS4VsT1tnI0OnjwqQtd4fRC2TQFogSYbk\nDfmtFxiiQymF9CtlRbTQX41gZ6RMLRIyeA96k7WC3PJiIlqiFDp0172INTU0NsiX\nfJ/u2plLINtye67yUt38TGOYZa1aF/mjAtN+tubumY1Va7k/ec4b+qhZQUOzgGZv\nTrx5wDYy8UBeUkqn7/ZVH7FDAN6wcc97e49/02okiH7pu9bSlP9Izl6YSaLBRcAy\nTAixepqGxVjhK1OmKMIGrIiI2H9oEelpNImMvgQo8XK+2Bcvnw/H5qQg8VmFwPd7\ndfeszhlA0vV4mgGQMm5T\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nMIIEizCCA3OgAwIBAgIORvCM288sVGbvMwHdXzQwDQYJKoZIhvcNAQELBQAwVzEL\nMAkGA1UEBhMCQkUxGTAXBgNVBAoTEEdsb2JhbFNpZ24gbnYtc2ExEDAOBgNVBAsT\nB1Jvb3QgQ0ExGzAZBgNVBAMTEkdsb2JhbFNpZ24gUm9vdCBDQTAeFw0xNTA4MTkw\nMDAwMDBaFw0yNTA4MTkwMDAwMDBaMFcxCzAJBgNVBAYTAkJFMRkwFwYDVQQKExBH\nbG9iYWxTaWduIG52LXNhMS0wKwYDVQQDEyRHbG9iYWxTaWduIENsb3VkU1NMIENB\nIC0gU0hBMjU2IC0gRzMwggEiMA0GCSqGSIb3DQEBAQUAA4IBDwAwggEKAoIBAQCj\nwHXhMpjl2a6EfI3oI19GlVtMoiVw15AEhYDJtfSKZU2Sy6XEQqC2eSUx7fGFIM0T\nUT1nrJdNaJszhlyzey2q33egYdH1PPua/NPVlMrJHoAbkJDIrI32YBecMbjFYaLi\nblclCG8kmZnPlL/Hi2uwH8oU+hibbBB8mSvaSmPlsk7C/T4QC0j0dwsv8JZLOu69\nNd6FjdoTDs4BxHHT03fFCKZgOSWnJ2lcg9FvdnjuxURbRb0pO+LGCQ+ivivc41za\nWm+O58kHa36hwFOVgongeFxyqGy+Z2ur5zPZh/L4XCf09io7h+/awkfav6zrJ2R7\nTFPrNOEvmyBNVBJrfSi9AgMBAAGjggFTMIIBTzAOBgNVHQ8BAf8EBAMCAQYwHQYD\nVR0lBBYwFAYIKwYBBQUHAwEGCCsGAQUFBwMCMBIGA1UdEwEB/wQIMAYBAf8CAQAw\nHQYDVR0OBBYEFKkrh+HOJEc7G7/PhTcCVZ0NlFjmMB8GA1UdIwQYMBaAFGB7ZhpF\nDZfKiVAvfQTNNKj//P1LMD0GCCsGAQUFBwEBBDEwLzAtBggrBgEFBQcwAYYhaHR0\ncDovL29jc3AuZ2xvYmFsc2lnbi5jb20vcm9vdHIxMDMGA1UdHwQsMCowKKAmoCSG\nImh0dHA6Ly9jcmwuZ2xvYmFsc2lnbi5jb20vcm9vdC5jcmwwVgYDVR0gBE8wTTAL\nBgkrBgEEAaAyARQwPgYGZ4EMAQICMDQwMgYIKwYBBQUHAgEWJmh0dHBzOi8vd3d3\nLmdsb2JhbHNpZ24uY29tL3JlcG9zaXRvcnkvMA0GCSqGSIb3DQEBCwUAA4IBAQCi\nHWmKCo7EFIMqKhJNOSeQTvCNrNKWYkc2XpLR+sWTtTcHZSnS9FNQa8n0/jT13bgd\n+vzcFKxWlCecQqoETbftWNmZ0knmIC/Tp3e4Koka76fPhi3WU+kLk5xOq9lF7qSE\nhf805A7Au6XOX5WJhXCqwV3szyvT2YPfA8qBpwIyt3dhECVO2XTz2XmCtSZwtFK8\njzPXiq4Z0PySrS+6PKBIWEde/SBWlSDBch2rZpmk1Xg3SBufskw3Z3r9QtLTVp7T\nHY7EDGiWtkdREPd76xUJZPX58GMWLT3fI0I6k2PMq69PVwbH/hRVYs4nERnh9ELt\nIjBrNRpKBYCkZd/My2/Q\n-----END CERTIFICATE-----\n"))
-	return &ManagementClient{
-		authToken: authToken,
-		client:    &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}},
-		host:      fmt.Sprintf("https://%s", contentfulCPAURL),
-		pool:      pool,
-		spaceID:   "ygx37epqlss8",
+// Create adds a new content type. The content type is created with the given ID if set
+func (s *ContentTypeService) Create(ct *ContentType) error {
+	if ct.ID == "" {
+		return s.send("POST", "/content_types", nil, ct, ct)
 	}
+	return s.send("PUT", "/content_types/"+url.PathEscape(ct.ID), nil, ct, ct)
 }
 
-// Webhook describes a webhook definition
-type Webhook struct {
-	ID      string   `json:"-"`
-	Version int      `json:"-"`
-	URL     string   `json:"url"`
-	Name    string   `json:"name"`
-	Topics  []string `json:"topics"`
+// Update replaces an existing content type. It fails if the content type changed since ct.Version
+func (s *ContentTypeService) Update(ct *ContentType) error {
+	return s.send("PUT", "/content_types/"+url.PathEscape(ct.ID), versionHeader(ct.Version), ct, ct)
 }
 
-// WebhookIterator is used to paginate webhooks
-type WebhookIterator struct {
-	Page   int
-	Limit  int
-	Offset int
-	c      *ManagementClient
-	items  []Webhook
+// UpdateWithRetry applies fn to the latest version of a content type and updates it, retrying with a fresh version on conflicts
+func (s *ContentTypeService) UpdateWithRetry(id string, fn func(*ContentType) error) (*ContentType, error) {
+	return updateWithRetry(func() (*ContentType, error) {
+		return s.Get(id)
+	}, s.Update, fn)
 }
-type webhookItem struct {
-	Sys sys `json:"sys"`
-	Webhook
+
+// Delete removes an unpublished content type without entries
+func (s *ContentTypeService) Delete(id string) error {
+	return s.client.do("DELETE", "/content_types/"+url.PathEscape(id), nil, nil, nil)
 }
-type webhooksResponse struct {
-	Total int           `json:"total"`
-	Skip  int           `json:"skip"`
-	Limit int           `json:"limit"`
-	Items []webhookItem `json:"items"`
+
+// Publish activates the current version of a content type
+func (s *ContentTypeService) Publish(ct *ContentType) error {
+	return s.send("PUT", "/content_types/"+url.PathEscape(ct.ID)+"/published", versionHeader(ct.Version), ct, nil)
 }
 
-// Next returns the following item of type Webhook. If none exists a network request will be executed
-func (it *WebhookIterator) Next() (*Webhook, error) {
+// Unpublish deactivates a content type. Content types with entries cannot be deactivated
+func (s *ContentTypeService) Unpublish(ct *ContentType) error {
+	return s.send("DELETE", "/content_types/"+url.PathEscape(ct.ID)+"/published", versionHeader(ct.Version), ct, nil)
+}
+
+// ContentTypeIterator is used to paginate content types of the management api
+type ContentTypeIterator struct {
+	Limit   int
+	Offset  int
+	total   int
+	fetched bool
+	c       *ManagementClient
+	items   []*ContentType
+}
+
+// setPage records a fetched page in the pagination state
+func (it *ContentTypeIterator) setPage(items []*ContentType, total int) {
+	it.items = items
+	it.total = total
+	it.fetched = true
+	it.Offset += len(items)
+	if len(items) == 0 {
+		// items were removed since the total was reported
+		it.total = it.Offset
+	}
+}
+
+// Next returns the following item of type ContentType. If none exists a network request will be executed
+func (it *ContentTypeIterator) Next() (*ContentType, error) {
 	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
 		if err := it.fetch(); err != nil {
 			return nil, err
 		}
@@ -839,148 +6844,113 @@ func (it *WebhookIterator) Next() (*Webhook, error) {
 	if len(it.items) == 0 {
 		return nil, ErrIteratorDone
 	}
-	var item Webhook
-	item, it.items = it.items[len(it.items)-1], it.items[:len(it.items)-1]
-	if len(it.items) == 0 {
-		it.Page++
-		it.Offset = it.Page * it.Limit
-	}
-	return &item, nil
+	var item *ContentType
+	item, it.items = it.items[0], it.items[1:]
+	return item, nil
 }
-func (it *WebhookIterator) fetch() error {
-	c := it.c
-	var url = fmt.Sprintf("https://api.contentful.com/spaces/%s/webhook_definitions?limit=%d&skip=%d", c.spaceID, it.Limit, it.Offset)
-	var req, err = http.NewRequest("GET", url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.authToken))
-	req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
-	}
-	var data webhooksResponse
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return err
-	}
-	if err := resp.Body.Close(); err != nil {
-		return err
+
+// Page returns the remaining items of the current page of ContentType. If none exists a network request will be executed
+func (it *ContentTypeIterator) Page() ([]*ContentType, error) {
+	if len(it.items) == 0 {
+		if !it.HasMore() {
+			return nil, ErrIteratorDone
+		}
+		if err := it.fetch(); err != nil {
+			return nil, err
+		}
 	}
-	it.items = []Webhook{}
-	for _, i := range data.Items {
-		i.Webhook.ID = i.Sys.ID
-		i.Webhook.Version = i.Sys.Version
-		it.items = append(it.items, i.Webhook)
+	if len(it.items) == 0 {
+		return nil, ErrIteratorDone
 	}
-	return nil
+	items := it.items
+	it.items = nil
+	return items, nil
 }
 
-// List retrieves paginated webhooks
-func (ws *WebhookService) List(opts ListOptions) *WebhookIterator {
-	if opts.Limit <= 0 {
-		opts.Limit = 100
-	}
-	it := &WebhookIterator{
-		Limit: opts.Limit,
-		Page:  opts.Page,
-		c:     ws.client,
+// All returns a range-over-func sequence of all remaining ContentType items. Iteration stops after the first error
+func (it *ContentTypeIterator) All() iter.Seq2[*ContentType, error] {
+	return func(yield func(*ContentType, error) bool) {
+		for {
+			item, err := it.Next()
+			if errors.Is(err, ErrIteratorDone) {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
 	}
-	return it
 }
 
-// Create adds a new webhook definitions
-func (ws *WebhookService) Create(w *Webhook) error {
-	var url = fmt.Sprintf("https://api.contentful.com/spaces/%s/webhook_definitions", ws.client.spaceID)
-	b := bytes.Buffer{}
-	var payload = webhookItem{Webhook: *w}
-	if err := json.NewEncoder(&b).Encode(payload); err != nil {
-		return err
-	}
-	var req, err = http.NewRequest("POST", url, &b)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ws.client.authToken))
-	req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
-	resp, err := ws.client.client.Do(req)
-	if err != nil {
-		return err
-	}
-	if resp.StatusCode != http.StatusCreated {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
-	}
-	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
-		return err
+// Collect returns up to max remaining ContentType items. A max of zero or less collects all items
+func (it *ContentTypeIterator) Collect(max int) ([]*ContentType, error) {
+	var items []*ContentType
+	for item, err := range it.All() {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+		if max > 0 && len(items) >= max {
+			break
+		}
 	}
-	if err := resp.Body.Close(); err != nil {
-		return err
+	return items, nil
+}
+
+// ForEach calls fn for every remaining ContentType item and stops at the first error
+func (it *ContentTypeIterator) ForEach(fn func(*ContentType) error) error {
+	for item, err := range it.All() {
+		if err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
 	}
-	w.ID = payload.Sys.ID
-	w.Version = payload.Sys.Version
 	return nil
 }
 
-// Update changes an existing webhook definitions
-func (ws *WebhookService) Update(w *Webhook) error {
-	var url = fmt.Sprintf("https://api.contentful.com/spaces/%s/webhook_definitions/%s", ws.client.spaceID, w.ID)
-	b := bytes.Buffer{}
-	var payload = webhookItem{Webhook: *w}
-	if err := json.NewEncoder(&b).Encode(payload); err != nil {
-		return err
-	}
-	var req, err = http.NewRequest("PUT", url, &b)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ws.client.authToken))
-	req.Header.Set("X-Contentful-Version", fmt.Sprintf("%d", w.Version))
-	req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
-	resp, err := ws.client.client.Do(req)
-	if err != nil {
-		return err
+// Total returns the number of items matching the iterator. The first page is fetched if necessary
+func (it *ContentTypeIterator) Total() (int, error) {
+	if !it.fetched {
+		if err := it.fetch(); err != nil {
+			return 0, err
+		}
 	}
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
+	return it.total, nil
+}
+
+// HasMore reports whether Next can return further items of type ContentType
+func (it *ContentTypeIterator) HasMore() bool {
+	return len(it.items) > 0 || !it.fetched || it.Offset < it.total
+}
+func (it *ContentTypeIterator) fetch() error {
+	var data struct {
+		Total int               `json:"total"`
+		Items []contentTypeItem `json:"items"`
 	}
-	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+	if err := it.c.do("GET", fmt.Sprintf("/content_types?limit=%d&skip=%d&order=%s", it.Limit, it.Offset, defaultOrder), nil, nil, &data); err != nil {
 		return err
 	}
-	if err := resp.Body.Close(); err != nil {
-		return err
+	items := make([]*ContentType, len(data.Items))
+	for i, raw := range data.Items {
+		ct := raw.ContentType
+		ct.ID = raw.Sys.ID
+		ct.Version = raw.Sys.Version
+		items[i] = &ct
 	}
-	*w = payload.Webhook
+	it.setPage(items, data.Total)
 	return nil
 }
 
-// Delete adds a new webhook definitions
-func (ws *WebhookService) Delete(id string) error {
-	var url = fmt.Sprintf("https://api.contentful.com/spaces/%s/webhook_definitions/%s", ws.client.spaceID, id)
-	var req, err = http.NewRequest("DELETE", url, nil)
-	if err != nil {
-		return err
-	}
-	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", ws.client.authToken))
-	req.Header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
-	resp, err := ws.client.client.Do(req)
-	if err != nil {
-		return err
+// List retrieves paginated content types including unpublished drafts
+func (s *ContentTypeService) List(opts ListOptions) *ContentTypeIterator {
+	if opts.Limit <= 0 {
+		opts.Limit = 100
 	}
-	if resp.StatusCode != http.StatusNoContent {
-		return fmt.Errorf("Request failed: %s, %v", resp.Status, err)
+	return &ContentTypeIterator{
+		Limit:  opts.Limit,
+		Offset: opts.Page * opts.Limit,
+		c:      s.client,
 	}
-	return resp.Body.Close()
-}
-
-// WebhookService includes webhook management functions
-type WebhookService struct {
-	client *ManagementClient
-}
-
-// Webhooks returns a Webhook management service
-func (c *ManagementClient) Webhooks() *WebhookService {
-	return &WebhookService{client: c}
 }
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEntryByID(t *testing.T) {
+	c := newPostServer(t, &postServer{n: 5})
+	p, err := c.Post("p3")
+	if err != nil || p.ID != "p3" || p.Title != "t3" {
+		t.Fatalf("got %+v, %v", p, err)
+	}
+	var notFound *NotFoundError
+	if _, err := c.Post("p9"); !errors.As(err, &notFound) || notFound.ID != "p9" || notFound.ContentType != postContentType {
+		t.Fatalf("got %v, want NotFoundError", err)
+	}
+	e, err := c.Entry("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p, ok := e.(*Post); !ok || p.Slug != "s2" {
+		t.Fatalf("got %#v", e)
+	}
+}
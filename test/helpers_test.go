@@ -0,0 +1,99 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const postContentType = "2wKn6yEnZewu2SCCkus4as"
+
+// newTestCDA returns a delivery client sending its requests to srv
+func newTestCDA(t *testing.T, srv *httptest.Server) *ContentClient {
+	t.Helper()
+	c := NewCDA("token", "en-US")
+	c.host = srv.URL
+	c.client = srv.Client()
+	return c
+}
+
+// newTestManagement returns a management client sending its requests to srv
+func newTestManagement(t *testing.T, srv *httptest.Server) *ManagementClient {
+	t.Helper()
+	m := NewManagement("token")
+	m.host = srv.URL
+	m.uploadHost = srv.URL
+	m.spaceID = "space"
+	m.client = srv.Client()
+	return m
+}
+
+// postServer serves n posts with ids p0...pn-1, filtered by sys.id and paginated by skip and limit. fail, if set, answers a request with its status instead
+type postServer struct {
+	n        int
+	fail     func(skip int) int
+	mu       sync.Mutex
+	inFlight int
+	maxLoad  int
+	requests int32
+}
+
+func (s *postServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt32(&s.requests, 1)
+	s.mu.Lock()
+	s.inFlight++
+	if s.inFlight > s.maxLoad {
+		s.maxLoad = s.inFlight
+	}
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+	// give concurrent requests a chance to overlap
+	time.Sleep(5 * time.Millisecond)
+
+	q := r.URL.Query()
+	skip, _ := strconv.Atoi(q.Get("skip"))
+	limit, _ := strconv.Atoi(q.Get("limit"))
+	if s.fail != nil {
+		if status := s.fail(skip); status != 0 {
+			w.WriteHeader(status)
+			return
+		}
+	}
+	ids := []int{}
+	for i := 0; i < s.n; i++ {
+		if id := q.Get("sys.id"); id == "" || id == fmt.Sprintf("p%d", i) {
+			ids = append(ids, i)
+		}
+	}
+	items := []map[string]interface{}{}
+	for _, i := range ids[min(skip, len(ids)):min(skip+limit, len(ids))] {
+		items = append(items, map[string]interface{}{
+			"sys":    map[string]interface{}{"id": fmt.Sprintf("p%d", i), "type": "Entry", "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": postContentType}}},
+			"fields": map[string]interface{}{"title": fmt.Sprintf("t%d", i), "slug": fmt.Sprintf("s%d", i)},
+		})
+	}
+	json.NewEncoder(w).Encode(map[string]interface{}{"total": len(ids), "skip": skip, "limit": limit, "items": items, "includes": map[string]interface{}{}})
+}
+
+func newPostServer(t *testing.T, s *postServer) *ContentClient {
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	c := newTestCDA(t, srv)
+	c.SetRateLimit(0)
+	return c
+}
+
+// testEntry returns the json of an entry of contentType holding fields
+func testEntry(id, contentType string, fields map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"sys": map[string]interface{}{"id": id, "type": "Entry", "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": contentType}}}, "fields": fields}
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func rawEntry(id, contentType, fields string) includeEntry {
+	var e includeEntry
+	e.Sys.ID, e.Sys.ContentType.Sys.ID = id, contentType
+	raw := json.RawMessage(fields)
+	e.Fields = &raw
+	return e
+}
+
+// cachedClient returns a client caching a post linking to an author, which links to an asset
+func cachedClient(t *testing.T, cache Cache) *ContentClient {
+	t.Helper()
+	c := NewCDA("token", "en-US")
+	c.SetCache(cache)
+	index := newLinkIndex([]includeEntry{
+		rawEntry("p0", postContentType, `{"approver":{"sys":{"id":"a1"}}}`),
+	}, includes{
+		Entries: []includeEntry{rawEntry("a1", "1kUEViTN4EmGiEaaeC6ouY", `{"name":"a1","profilePhoto":{"sys":{"id":"img"}}}`)},
+		Assets:  []includeAsset{{Sys: sys{ID: "img"}}},
+	})
+	if _, ok := resolvePost("p0", index, c.newCache()); !ok {
+		t.Fatal("post not resolved")
+	}
+	return c
+}
+
+const webhookSecret = "s3cret"
+
+// signedWebhook returns a webhook request signed with secret at time ts
+func signedWebhook(body, secret string, ts time.Time) *http.Request {
+	stamp := strconv.FormatInt(ts.UnixMilli(), 10)
+	req := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	req.Header.Set("X-Contentful-Topic", "ContentManagement.Asset.publish")
+	req.Header.Set("X-Contentful-Timestamp", stamp)
+	req.Header.Set("X-Contentful-Signed-Headers", "x-contentful-timestamp,x-contentful-topic")
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("POST\n/hook\nx-contentful-timestamp:" + stamp + ";x-contentful-topic:ContentManagement.Asset.publish\n" + body))
+	req.Header.Set("X-Contentful-Signature", hex.EncodeToString(mac.Sum(nil)))
+	return req
+}
+
+func TestWebhookEvictsLinkingEntries(t *testing.T) {
+	c := cachedClient(t, NewLRUCache(0, 0))
+	for _, key := range []string{"Entry:p0", "Entry:a1", "Asset:img"} {
+		if _, ok := c.cache.get(key); !ok {
+			t.Fatalf("%s not cached", key)
+		}
+	}
+	h := c.WebhookHandler()
+	h.Secret = webhookSecret
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, signedWebhook(`{"sys":{"id":"img","type":"Asset"}}`, webhookSecret, time.Now()))
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("unexpected status %d: %s", rec.Code, rec.Body.String())
+	}
+	for _, key := range []string{"Entry:p0", "Entry:a1", "Asset:img"} {
+		if _, ok := c.cache.get(key); ok {
+			t.Fatalf("%s still cached", key)
+		}
+	}
+	if len(c.cache.linkedBy) != 0 || len(c.cache.types) != 0 || len(c.cache.links) != 0 {
+		t.Fatalf("reverse indexes not pruned: %v %v %v", c.cache.linkedBy, c.cache.types, c.cache.links)
+	}
+}
+
+func TestWebhookVerification(t *testing.T) {
+	body := `{"sys":{"id":"img","type":"Asset"}}`
+	unsigned := func() *http.Request {
+		req := signedWebhook(body, webhookSecret, time.Now())
+		req.Header.Del("X-Contentful-Signature")
+		return req
+	}
+	basicAuth := func(username, password string) *http.Request {
+		req := signedWebhook(body, "", time.Now())
+		req.SetBasicAuth(username, password)
+		return req
+	}
+	for _, tc := range []struct {
+		name               string
+		secret, user, pass string
+		req                *http.Request
+		status             int
+	}{
+		{"unconfigured handler", "", "", "", signedWebhook(body, "", time.Now()), http.StatusUnauthorized},
+		{"valid signature", webhookSecret, "", "", signedWebhook(body, webhookSecret, time.Now()), http.StatusNoContent},
+		{"bad signature", webhookSecret, "", "", signedWebhook(body, "wrong", time.Now()), http.StatusUnauthorized},
+		{"stale timestamp", webhookSecret, "", "", signedWebhook(body, webhookSecret, time.Now().Add(-time.Minute)), http.StatusUnauthorized},
+		{"future timestamp", webhookSecret, "", "", signedWebhook(body, webhookSecret, time.Now().Add(time.Minute)), http.StatusUnauthorized},
+		{"missing signature", webhookSecret, "", "", unsigned(), http.StatusUnauthorized},
+		{"modified body", webhookSecret, "", "", func() *http.Request {
+			req := signedWebhook(body, webhookSecret, time.Now())
+			req.Body = http.NoBody
+			return req
+		}(), http.StatusUnauthorized},
+		{"valid basic auth", "", "hook", "pw", basicAuth("hook", "pw"), http.StatusNoContent},
+		{"wrong basic auth", "", "hook", "pw", basicAuth("hook", "nope"), http.StatusUnauthorized},
+		{"basic auth without signature", webhookSecret, "hook", "pw", func() *http.Request {
+			req := unsigned()
+			req.SetBasicAuth("hook", "pw")
+			return req
+		}(), http.StatusUnauthorized},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			c := cachedClient(t, NewLRUCache(0, 0))
+			h := c.WebhookHandler()
+			h.Secret, h.Username, h.Password = tc.secret, tc.user, tc.pass
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, tc.req)
+			if rec.Code != tc.status {
+				t.Fatalf("expected status %d, got %d", tc.status, rec.Code)
+			}
+			_, cached := c.cache.get("Asset:img")
+			if cached == (tc.status == http.StatusNoContent) {
+				t.Fatalf("unexpected cache state after status %d", rec.Code)
+			}
+		})
+	}
+}
+
+func TestCacheIndexesFollowLRUEviction(t *testing.T) {
+	c := cachedClient(t, NewLRUCache(1, 0))
+	// the cache holds only the most recently resolved value, the evicted entries must leave the indexes
+	if len(c.cache.links) > 1 || len(c.cache.typeOf) > 1 {
+		t.Fatalf("indexes hold evicted entries: %v %v", c.cache.links, c.cache.typeOf)
+	}
+	for target, linkers := range c.cache.linkedBy {
+		for key := range linkers {
+			if _, ok := c.cache.links[key]; !ok {
+				t.Fatalf("%s is indexed as linking to %s after its eviction", key, target)
+			}
+		}
+	}
+}
+
+func TestCacheIndexesFollowTTLExpiry(t *testing.T) {
+	c := cachedClient(t, NewLRUCache(0, time.Millisecond))
+	time.Sleep(5 * time.Millisecond)
+	if _, ok := c.cache.get("Entry:p0"); ok {
+		t.Fatal("expired entry returned")
+	}
+	if _, ok := c.cache.links["Entry:p0"]; ok {
+		t.Fatal("expired entry still indexed")
+	}
+	// setting any value drops the remaining expired values
+	c.cache.setAsset(&includeAsset{Sys: sys{ID: "other"}})
+	if len(c.cache.linkedBy) != 0 || len(c.cache.types) != 0 || len(c.cache.links) != 1 || len(c.cache.typeOf) != 0 {
+		t.Fatalf("indexes hold expired entries: %v %v %v %v", c.cache.linkedBy, c.cache.types, c.cache.links, c.cache.typeOf)
+	}
+}
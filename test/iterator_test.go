@@ -0,0 +1,283 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestIteratorOrder(t *testing.T) {
+	s := &postServer{n: 25}
+	c := newPostServer(t, s)
+	items, err := c.Posts(ListOptions{Limit: 10}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 25 {
+		t.Fatalf("got %d items", len(items))
+	}
+	for i, p := range items {
+		if p.ID != fmt.Sprintf("p%d", i) {
+			t.Fatalf("item %d is %s", i, p.ID)
+		}
+	}
+	if s.requests != 3 {
+		t.Fatalf("sent %d requests, want 3", s.requests)
+	}
+}
+
+func TestIteratorPrefetch(t *testing.T) {
+	for _, prefetch := range []int{1, 3, 20} {
+		t.Run(strconv.Itoa(prefetch), func(t *testing.T) {
+			s := &postServer{n: 95}
+			c := newPostServer(t, s)
+			it := c.Posts(ListOptions{Limit: 10, Prefetch: prefetch})
+			i := 0
+			for p, err := range it.All() {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if p.ID != fmt.Sprintf("p%d", i) {
+					t.Fatalf("item %d is %s", i, p.ID)
+				}
+				i++
+			}
+			if i != 95 {
+				t.Fatalf("got %d items", i)
+			}
+			if s.requests != 10 {
+				t.Fatalf("sent %d requests, want 10", s.requests)
+			}
+			if s.maxLoad > prefetch+1 {
+				t.Fatalf("%d concurrent requests with prefetch %d", s.maxLoad, prefetch)
+			}
+			if total, _ := it.Total(); total != 95 {
+				t.Fatalf("total %d", total)
+			}
+		})
+	}
+}
+
+func TestIteratorPrefetchStopsOnError(t *testing.T) {
+	s := &postServer{n: 95, fail: func(skip int) int {
+		if skip == 30 {
+			return http.StatusInternalServerError
+		}
+		return 0
+	}}
+	c := newPostServer(t, s)
+	i := 0
+	var err error
+	for p, e := range c.Posts(ListOptions{Limit: 10, Prefetch: 3}).All() {
+		if e != nil {
+			err = e
+			break
+		}
+		if p.ID != fmt.Sprintf("p%d", i) {
+			t.Fatalf("item %d is %s", i, p.ID)
+		}
+		i++
+	}
+	if err == nil || i != 30 {
+		t.Fatalf("got %d items and %v, want 30 items and an error", i, err)
+	}
+}
+
+func TestTooManyRequestsAreRetried(t *testing.T) {
+	asset := map[string]interface{}{
+		"sys":    map[string]interface{}{"id": "a1", "type": "Asset"},
+		"fields": map[string]interface{}{"title": "image"},
+	}
+	post := map[string]interface{}{
+		"sys":    map[string]interface{}{"id": "p1", "type": "Entry", "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": postContentType}}},
+		"fields": map[string]interface{}{"title": "post"},
+	}
+	list := func(item interface{}) interface{} {
+		return map[string]interface{}{"total": 1, "skip": 0, "limit": 100, "items": []interface{}{item}, "includes": map[string]interface{}{}}
+	}
+	tests := []struct {
+		name string
+		body interface{}
+		call func(c *ContentClient) error
+	}{
+		{"Entry", list(post), func(c *ContentClient) error {
+			_, err := c.Entry("p1")
+			return err
+		}},
+		{"Posts", list(post), func(c *ContentClient) error {
+			_, err := c.Posts(ListOptions{}).Collect(0)
+			return err
+		}},
+		{"Asset", asset, func(c *ContentClient) error {
+			_, err := c.Asset("a1")
+			return err
+		}},
+		{"Assets", list(asset), func(c *ContentClient) error {
+			_, err := c.Assets(AssetListOptions{}).Collect(0)
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusTooManyRequests)
+					return
+				}
+				json.NewEncoder(w).Encode(tt.body)
+			}))
+			defer srv.Close()
+			if err := tt.call(newTestCDA(t, srv)); err != nil {
+				t.Fatal(err)
+			}
+			if requests != 2 {
+				t.Fatalf("sent %d requests, want 2", requests)
+			}
+		})
+	}
+}
+
+func TestTooManyRequestsGivesUp(t *testing.T) {
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("X-Contentful-RateLimit-Reset", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+	if _, err := newTestCDA(t, srv).Asset("a1"); err == nil {
+		t.Fatal("expected an error")
+	}
+	if requests != 3 {
+		t.Fatalf("sent %d requests, want 3", requests)
+	}
+}
+
+func TestNextYieldsPagesInOrder(t *testing.T) {
+	c := newPostServer(t, &postServer{n: 7})
+	it := c.Posts(ListOptions{Limit: 3})
+	for i := 0; i < 7; i++ {
+		p, err := it.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if p.ID != fmt.Sprintf("p%d", i) {
+			t.Fatalf("item %d is %s", i, p.ID)
+		}
+	}
+	if _, err := it.Next(); err != ErrIteratorDone {
+		t.Fatalf("got %v after the last item, want ErrIteratorDone", err)
+	}
+}
+
+func TestIteratorPagination(t *testing.T) {
+	tests := []struct {
+		n     int
+		pages []int
+	}{
+		{0, nil},
+		{5, []int{5}},
+		{10, []int{5, 5}},
+		{12, []int{5, 5, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.n), func(t *testing.T) {
+			s := &postServer{n: tt.n}
+			it := newPostServer(t, s).Posts(ListOptions{Limit: 5})
+			if total, err := it.Total(); err != nil || total != tt.n {
+				t.Fatalf("total %d, %v", total, err)
+			}
+			var pages []int
+			for it.HasMore() {
+				page, err := it.Page()
+				if err == ErrIteratorDone {
+					break
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+				pages = append(pages, len(page))
+				if it.Offset != sumInts(pages) {
+					t.Fatalf("offset %d after pages %v", it.Offset, pages)
+				}
+			}
+			if fmt.Sprint(pages) != fmt.Sprint(tt.pages) {
+				t.Fatalf("got pages %v, want %v", pages, tt.pages)
+			}
+			// Total fetched the first page, which is consumed instead of requested again
+			if want := max(len(tt.pages), 1); int(s.requests) != want {
+				t.Fatalf("sent %d requests, want %d", s.requests, want)
+			}
+		})
+	}
+}
+
+func sumInts(vs []int) int {
+	sum := 0
+	for _, v := range vs {
+		sum += v
+	}
+	return sum
+}
+
+func TestIteratorHelpersStopEarly(t *testing.T) {
+	stop := errors.New("stop")
+	tests := []struct {
+		name     string
+		run      func(it *PostIterator) (int, error)
+		items    int
+		err      error
+		requests int32
+	}{
+		{"Collect", func(it *PostIterator) (int, error) {
+			items, err := it.Collect(0)
+			return len(items), err
+		}, 25, nil, 3},
+		{"CollectMax", func(it *PostIterator) (int, error) {
+			items, err := it.Collect(12)
+			return len(items), err
+		}, 12, nil, 2},
+		{"ForEachError", func(it *PostIterator) (int, error) {
+			n := 0
+			err := it.ForEach(func(p *Post) error {
+				if p.ID == "p4" {
+					return stop
+				}
+				n++
+				return nil
+			})
+			return n, err
+		}, 4, stop, 1},
+		{"AllBreak", func(it *PostIterator) (int, error) {
+			n := 0
+			for _, err := range it.All() {
+				if err != nil {
+					return n, err
+				}
+				if n++; n == 10 {
+					break
+				}
+			}
+			return n, nil
+		}, 10, nil, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &postServer{n: 25}
+			n, err := tt.run(newPostServer(t, s).Posts(ListOptions{Limit: 10}))
+			if n != tt.items || err != tt.err {
+				t.Fatalf("got %d items and %v, want %d and %v", n, err, tt.items, tt.err)
+			}
+			if s.requests != tt.requests {
+				t.Fatalf("sent %d requests, want %d", s.requests, tt.requests)
+			}
+		})
+	}
+}
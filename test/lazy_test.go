@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestFetchMissingLinks(t *testing.T) {
+	const author = "1kUEViTN4EmGiEaaeC6ouY"
+	// entries outside the include depth of the list response
+	linked := map[string]map[string]interface{}{
+		"deep": testEntry("deep", author, map[string]interface{}{"name": "deep", "profilePhoto": benchmarkLink("Asset", "img"), "createdEntries": []interface{}{benchmarkLink("Entry", "p9")}}),
+		"p9":   testEntry("p9", postContentType, map[string]interface{}{"title": "p9", "authorOrPost": []interface{}{benchmarkLink("Entry", "deep")}}),
+	}
+	var mu sync.Mutex
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		requests = append(requests, r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]+"?"+q.Get("sys.id[in]"))
+		mu.Unlock()
+		if strings.HasSuffix(r.URL.Path, "/assets") {
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": []interface{}{map[string]interface{}{"sys": map[string]interface{}{"id": "img"}, "fields": map[string]interface{}{"title": "img"}}}})
+			return
+		}
+		if ids := q.Get("sys.id[in]"); ids != "" {
+			var items []interface{}
+			for _, id := range strings.Split(ids, ",") {
+				items = append(items, linked[id])
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": 2, "items": []interface{}{
+			testEntry("p0", postContentType, map[string]interface{}{"title": "p0", "approver": benchmarkLink("Entry", "deep")}),
+			testEntry("p1", postContentType, map[string]interface{}{"title": "p1", "approver": benchmarkLink("Entry", "deep"), "authorOrPost": []interface{}{benchmarkLink("Entry", "p9")}}),
+		}})
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.SetRateLimit(0)
+	c.SetFetchMissingLinks(true)
+
+	posts, err := c.Posts(ListOptions{Limit: 1}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := posts[0].Approver
+	if !a.Resolved || a.Value.Name != "deep" || !a.Value.ProfilePhoto.Resolved || len(a.Value.CreatedEntries) != 1 || !a.Value.CreatedEntries[0].Resolved {
+		t.Fatalf("approver %+v", a)
+	}
+	if !posts[1].AuthorOrPost[0].Resolved || !posts[1].Approver.Resolved {
+		t.Fatalf("post %+v", posts[1])
+	}
+	// every missing entry and asset is requested once, and links of fetched entries are followed as well
+	sort.Strings(requests)
+	if got := strings.Join(requests, ","); got != "assets?img,entries?,entries?deep,entries?p9" {
+		t.Fatalf("requests %s", got)
+	}
+}
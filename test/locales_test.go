@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func localeServer(t *testing.T, locales string, queried *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/locales") {
+			w.Write([]byte(locales))
+			return
+		}
+		*queried = append(*queried, r.URL.Query().Get("locale"))
+		if r.URL.Query().Get("locale") != "*" {
+			w.Write([]byte(`{"total":1,"items":[{"sys":{"id":"p","type":"Entry","contentType":{"sys":{"id":"` + postContentType + `"}}},"fields":{"title":"Hello"}}]}`))
+			return
+		}
+		w.Write([]byte(`{"total":1,"items":[{"sys":{"id":"p","type":"Entry","contentType":{"sys":{"id":"` + postContentType + `"}}},"fields":{
+			"title":{"de":"Hallo","de-DE":"Hallo","en-US":"Hello"},
+			"slug":{"de-CH":"gruezi","en-US":"hello"},
+			"body":{"en-US":"text","de-CH":null},
+			"featuredImage":{"en-US":{"sys":{"type":"Link","linkType":"Asset","id":"a"}}}}}],
+			"includes":{"Asset":[{"sys":{"id":"a","type":"Asset"},"fields":{"title":{"de-DE":"Bild","en-US":"Image"},"file":{"en-US":{"url":"//x/a.png"}}}}]}}`))
+	}))
+}
+
+func TestLocaleFallbackChain(t *testing.T) {
+	var queried []string
+	srv := localeServer(t, `{"items":[{"code":"en-US","default":true},{"code":"de-DE","fallbackCode":"en-US"},{"code":"de-CH","fallbackCode":"de-DE"}]}`, &queried)
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+
+	posts, err := c.Posts(ListOptions{Locale: "de-CH"}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if posts[0].Title != "Hello" {
+		t.Fatalf("expected the server localized title before locales are known, got %q", posts[0].Title)
+	}
+	if _, err := c.Locales(); err != nil {
+		t.Fatal(err)
+	}
+	posts, err = c.Posts(ListOptions{Locale: "de-CH"}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := posts[0]
+	if p.Title != "Hallo" || p.Slug != "gruezi" || p.Body != "text" {
+		t.Fatalf("unexpected fields %+v", p)
+	}
+	if p.FeaturedImage.Value.Title != "Bild" || p.FeaturedImage.Value.URL != "https://x/a.png" {
+		t.Fatalf("unexpected asset %+v", p.FeaturedImage)
+	}
+	if got := strings.Join(queried, ","); got != "de-CH,*" {
+		t.Fatalf("unexpected locale parameters %s", got)
+	}
+}
+
+func TestLocaleWithoutFallbackUsesDefaultLocale(t *testing.T) {
+	var queried []string
+	srv := localeServer(t, `{"items":[{"code":"en-US","default":true},{"code":"de"}]}`, &queried)
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	if _, err := c.Locales(); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(c.fallbackChain("de"), ","); got != "de,en-US" {
+		t.Fatalf("unexpected chain %s", got)
+	}
+	posts, err := c.Posts(ListOptions{Locale: "de"}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := posts[0]
+	if p.Title != "Hallo" || p.Slug != "hello" {
+		t.Fatalf("expected the localized title and the default locale slug, got title=%q slug=%q", p.Title, p.Slug)
+	}
+	if p.FeaturedImage.Value.ID != "a" || p.FeaturedImage.Value.Title != "Image" {
+		t.Fatalf("expected the link stored in the default locale to resolve, got %+v", p.FeaturedImage)
+	}
+	if got := strings.Join(c.fallbackChain("en-US"), ","); got != "en-US" {
+		t.Fatalf("unexpected default locale chain %s", got)
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLocalizedPosts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/locales") {
+			w.Write([]byte(`{"items":[{"code":"en-US","default":true},{"code":"de-DE","fallbackCode":"en-US"}]}`))
+			return
+		}
+		if r.URL.Query().Get("locale") != "*" || r.URL.Query().Get("include") != "0" {
+			t.Errorf("unexpected query %s", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"total":1,"items":[{"sys":{"id":"p","type":"Entry","contentType":{"sys":{"id":"2wKn6yEnZewu2SCCkus4as"}}},"fields":{
+			"title":{"de-DE":"Hallo","en-US":"Hello"},
+			"slug":{"en-US":"hi"},
+			"body":{"en-US":"text"},
+			"tags":{"en-US":["a","b"]},
+			"approver":{"en-US":{"sys":{"type":"Link","linkType":"Entry","id":"x"}}},
+			"author":{"en-US":[{"sys":{"type":"Link","linkType":"Entry","id":"y"}}]}}}]}`))
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	if _, err := c.Locales(); err != nil {
+		t.Fatal(err)
+	}
+	posts, err := c.QueryLocalizedPosts(PostQuery().SlugEquals("hi"), ListOptions{}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := posts[0]
+	tests := []struct {
+		field  string
+		values interface{ Get(string) (string, bool) }
+		locale string
+		want   string
+		ok     bool
+	}{
+		{"title", p.Title, "de-DE", "Hallo", true},
+		{"title", p.Title, "en-US", "Hello", true},
+		{"body", p.Body, "de-DE", "text", true},
+		{"body", p.Body, "fr-FR", "", false},
+	}
+	for _, tt := range tests {
+		if v, ok := tt.values.Get(tt.locale); v != tt.want || ok != tt.ok {
+			t.Errorf("%s in %s is %q, %v, want %q, %v", tt.field, tt.locale, v, ok, tt.want, tt.ok)
+		}
+	}
+	if p.Slug != "hi" || p.Approver != "x" || p.Author[0] != "y" || len(p.Tags) != 2 {
+		t.Fatalf("unlocalized fields %+v", p)
+	}
+}
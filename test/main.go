@@ -25,11 +25,7 @@ func main() {
 
 		it := ws.List(ListOptions{Limit: 1})
 		fmt.Printf("Webhooks: \n")
-		for {
-			w, err := it.Next()
-			if err == ErrIteratorDone {
-				break
-			}
+		for w, err := range it.All() {
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -49,22 +45,18 @@ func main() {
 		c := NewCDA(os.Getenv("CONTENTFUL_CDA_AUTH_TOKEN"), "en-US")
 		it := c.Posts(ListOptions{Limit: 1, IncludeCount: 1})
 		fmt.Printf("Posts:\n")
-		for {
-			p, err := it.Next()
-			if err == ErrIteratorDone {
-				break
-			}
+		for p, err := range it.All() {
 			if err != nil {
 				log.Fatal(err)
 			}
 			fmt.Printf("ID: %s\n", p.ID)
 			fmt.Printf("Title: %s, by ", p.Title)
 			for _, a := range p.Author {
-				fmt.Printf("%s ", a.Name)
+				fmt.Printf("%s ", a.Value.Name)
 			}
 			fmt.Printf("\nCategories:")
 			for _, c := range p.Category {
-				fmt.Printf("%s,", c.Title)
+				fmt.Printf("%s,", c.Value.Title)
 			}
 			fmt.Printf("\n")
 			fmt.Printf("Tags: %v\n", p.Tags)
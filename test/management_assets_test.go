@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type onlyReader struct{ io.Reader }
+
+func TestManagementAssets(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	version := 1
+	gets := 0
+	var fields map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, r.Method+" "+r.URL.Path+" v="+r.Header.Get("X-Contentful-Version"))
+		body, _ := io.ReadAll(r.Body)
+		if r.URL.Path == "/spaces/space/uploads" {
+			if r.Header.Get("Content-Type") != "application/octet-stream" || string(body) != "imagedata" {
+				t.Errorf("upload %v %q", r.Header, body)
+			}
+			if len(r.TransferEncoding) > 0 {
+				calls = append(calls, "chunked")
+			}
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"sys":{"id":"up1","type":"Upload","expiresAt":"2026-10-20T00:00:00Z"}}`)
+			return
+		}
+		if r.Method == "PUT" && strings.HasSuffix(r.URL.Path, "/process") {
+			// every process request creates a new version of the asset
+			if r.Header.Get("X-Contentful-Version") != strconv.Itoa(version) {
+				w.WriteHeader(http.StatusConflict)
+				io.WriteString(w, `{"sys":{"type":"Error","id":"VersionMismatch"}}`)
+				return
+			}
+			version++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == "DELETE" && r.URL.Path == "/spaces/space/assets/a1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.Method == "POST" {
+			var in map[string]interface{}
+			json.Unmarshal(body, &in)
+			fields = in["fields"].(map[string]interface{})
+			w.WriteHeader(http.StatusCreated)
+		}
+		if r.Method == "GET" {
+			gets++
+			// processing finishes on the second poll after both locales were processed
+			if gets == 4 {
+				for _, file := range fields["file"].(map[string]interface{}) {
+					file := file.(map[string]interface{})
+					delete(file, "uploadFrom")
+					file["url"] = "//images.example/a1.png"
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"sys": map[string]interface{}{"id": "a1", "version": version}, "fields": fields})
+	}))
+	defer srv.Close()
+	s := newTestManagement(t, srv).Assets()
+	up, err := s.Upload(strings.NewReader("imagedata"))
+	if err != nil || up.ID != "up1" || up.ExpiresAt.Year() != 2026 {
+		t.Fatal(up, err)
+	}
+	if _, err := s.Upload(onlyReader{strings.NewReader("imagedata")}); err != nil {
+		t.Fatal(err)
+	}
+	a := &ManagedAsset{Fields: ManagedAssetFields{
+		Title: map[string]string{"en-US": "A"},
+		File: map[string]AssetFile{
+			"en-US": UploadedFile(up.ID, "a.png", "image/png"),
+			"de":    UploadedFile(up.ID, "a.png", "image/png"),
+		},
+	}}
+	if err := s.Create(a); err != nil || a.ID != "a1" || a.Fields.File["en-US"].UploadFrom.Sys.ID != "up1" {
+		t.Fatal(a, err)
+	}
+	if err := s.Process(a); err != nil || a.Version != 3 {
+		t.Fatal(a, err)
+	}
+	a, err = s.WaitProcessed("a1", 5*time.Second)
+	if err != nil || a.Fields.File["en-US"].URL == "" || a.Fields.File["de"].URL == "" || a.Version != 3 {
+		t.Fatal(a, err)
+	}
+	if err := s.Publish(a); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete("a1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.WaitProcessed("a1", 0, "de-DE"); err != ErrProcessingTimeout {
+		t.Fatal(err)
+	}
+	want := "POST /spaces/space/uploads v=|POST /spaces/space/uploads v=|chunked|POST /spaces/space/assets v=|PUT /spaces/space/assets/a1/files/de/process v=1|GET /spaces/space/assets/a1 v=|PUT /spaces/space/assets/a1/files/en-US/process v=2|GET /spaces/space/assets/a1 v=|GET /spaces/space/assets/a1 v=|GET /spaces/space/assets/a1 v=|PUT /spaces/space/assets/a1/published v=3|DELETE /spaces/space/assets/a1 v=|GET /spaces/space/assets/a1 v="
+	if got := strings.Join(calls, "|"); got != want {
+		t.Fatalf("got %s", got)
+	}
+	if RemoteFile("https://x/y.png", "y.png", "image/png").Upload != "https://x/y.png" {
+		t.Fatal("remote")
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestManagementEntries(t *testing.T) {
+	var calls []string
+	version := 1
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.Method+" "+r.URL.RequestURI()+" v="+r.Header.Get("X-Contentful-Version")+" ct="+r.Header.Get("X-Contentful-Content-Type"))
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("authorization %q", r.Header.Get("Authorization"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		var in map[string]interface{}
+		json.Unmarshal(body, &in)
+		fields := in["fields"]
+		if fields == nil {
+			fields = map[string]interface{}{"title": map[string]string{"en-US": "Stored"}}
+		}
+		if r.Method == "DELETE" && r.URL.Path == "/spaces/space/entries/p1" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		item := map[string]interface{}{"sys": map[string]interface{}{"id": "p1", "version": version, "contentType": map[string]interface{}{"sys": map[string]string{"id": "2wKn6yEnZewu2SCCkus4as"}}}, "fields": fields}
+		version++
+		if r.URL.Path == "/spaces/space/entries" && r.Method == "GET" {
+			json.NewEncoder(w).Encode(map[string]interface{}{"total": 1, "items": []interface{}{item}})
+			return
+		}
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+		}
+		json.NewEncoder(w).Encode(item)
+	}))
+	defer srv.Close()
+	m := newTestManagement(t, srv)
+	e := &PostEntry{Fields: PostEntryFields{
+		Title:  map[string]string{"en-US": "Hello", "de-DE": "Hallo"},
+		Author: map[string][]Link{"en-US": {EntryLink("a")}},
+		Date:   map[string]Date{"en-US": Date(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))},
+	}}
+	s := m.Posts()
+	if err := s.Create(e); err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != "p1" || e.Version != 1 || e.Fields.Title["de-DE"] != "Hallo" || e.Fields.Author["en-US"][0].Sys.ID != "a" {
+		t.Fatalf("%+v", e)
+	}
+	if !time.Time(e.Fields.Date["en-US"]).Equal(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Fatal(e.Fields.Date)
+	}
+	for _, fn := range []func(*PostEntry) error{s.Update, s.Publish, s.Unpublish, s.Archive, s.Unarchive} {
+		if err := fn(e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if e.Version != 6 {
+		t.Fatal(e.Version)
+	}
+	got, err := s.Get("p1")
+	if err != nil || got.Fields.Title["en-US"] != "Stored" {
+		t.Fatal(got, err)
+	}
+	all, err := s.List(ListOptions{}).Collect(0)
+	if err != nil || len(all) != 1 {
+		t.Fatal(all, err)
+	}
+	if err := s.Delete("p1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Authors().Get("p1"); err == nil {
+		t.Fatal("expected content type mismatch")
+	}
+	want := []string{
+		"POST /spaces/space/entries v= ct=2wKn6yEnZewu2SCCkus4as",
+		"PUT /spaces/space/entries/p1 v=1 ct=",
+		"PUT /spaces/space/entries/p1/published v=2 ct=",
+		"DELETE /spaces/space/entries/p1/published v=3 ct=",
+		"PUT /spaces/space/entries/p1/archived v=4 ct=",
+		"DELETE /spaces/space/entries/p1/archived v=5 ct=",
+		"GET /spaces/space/entries/p1 v= ct=",
+		"GET /spaces/space/entries?content_type=2wKn6yEnZewu2SCCkus4as&limit=100&skip=0&order=sys.createdAt,sys.id v= ct=",
+		"DELETE /spaces/space/entries/p1 v= ct=",
+		"GET /spaces/space/entries/p1 v= ct=",
+	}
+	if got := strings.Join(calls, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("requests\n%s", got)
+	}
+}
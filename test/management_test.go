@@ -0,0 +1,60 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewManagementUsesManagementHost(t *testing.T) {
+	if m := NewManagement("token"); m.host != "https://"+contentfulCMAURL {
+		t.Fatalf("management client sends its requests to %s", m.host)
+	}
+}
+
+func TestWebhooks(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI()+" v="+r.Header.Get("X-Contentful-Version")+" "+r.Header.Get("Authorization"))
+		hook := map[string]interface{}{"sys": map[string]interface{}{"id": "h", "version": 2}, "name": "hook"}
+		switch r.Method {
+		case "GET":
+			json.NewEncoder(w).Encode(map[string]interface{}{"total": 1, "items": []interface{}{hook}})
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(hook)
+		case "PUT":
+			json.NewEncoder(w).Encode(hook)
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+	ws := newTestManagement(t, srv).Webhooks()
+
+	hooks, err := ws.List(ListOptions{}).Collect(0)
+	if err != nil || len(hooks) != 1 || hooks[0].ID != "h" || hooks[0].Version != 2 {
+		t.Fatalf("got %+v, %v", hooks, err)
+	}
+	w := &Webhook{Name: "hook"}
+	if err := ws.Create(w); err != nil || w.ID != "h" || w.Version != 2 {
+		t.Fatalf("got %+v, %v", w, err)
+	}
+	if err := ws.Update(w); err != nil {
+		t.Fatal(err)
+	}
+	if err := ws.Delete("h"); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"GET /spaces/space/webhook_definitions?limit=100&skip=0 v= Bearer token",
+		"POST /spaces/space/webhook_definitions v= Bearer token",
+		"PUT /spaces/space/webhook_definitions/h v=2 Bearer token",
+		"DELETE /spaces/space/webhook_definitions/h v= Bearer token",
+	}
+	if got := strings.Join(requests, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("requests\n%s", got)
+	}
+}
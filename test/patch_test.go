@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEntryPatch(t *testing.T) {
+	var got []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" || r.URL.Path != "/spaces/space/entries/p1" || r.Header.Get("X-Contentful-Version") != "3" || r.Header.Get("Content-Type") != "application/json-patch+json" {
+			t.Errorf("unexpected %s %s %v", r.Method, r.URL, r.Header)
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		json.NewEncoder(w).Encode(map[string]interface{}{"sys": map[string]interface{}{"id": "p1", "version": 4, "contentType": map[string]interface{}{"sys": map[string]string{"id": "2wKn6yEnZewu2SCCkus4as"}}}, "fields": map[string]interface{}{"title": map[string]string{"en-US": "x"}}})
+	}))
+	defer srv.Close()
+	m := newTestManagement(t, srv)
+	e := &PostEntry{ID: "p1", Version: 3, Fields: PostEntryFields{
+		Title:    map[string]string{"en-US": "t"},
+		Tags:     map[string][]string{"en-US": {"a"}},
+		Author:   map[string][]Link{"en-US": nil},
+		Approver: map[string]Link{"de-DE": {}},
+		Body:     map[string]string{"de/DE": "b"},
+	}}
+	p := PostPatch().SetTitle("en-US", "x").AddTag("en-US", "go").SetAuthor("en-US", "a1", "a2").SetApprover("en-US", "a3").RemoveBody("de/DE")
+	if err := m.Posts().Patch(e, p); err != nil {
+		t.Fatal(err)
+	}
+	if e.Version != 4 || e.Fields.Title["en-US"] != "x" {
+		t.Fatalf("entry %+v", e)
+	}
+	b, _ := json.Marshal(got)
+	want := `[{"op":"add","path":"/fields/title/en-US","value":"x"},{"op":"add","path":"/fields/tags/en-US/-","value":"go"},{"op":"add","path":"/fields/author/en-US","value":[{"sys":{"id":"a1","linkType":"Entry","type":"Link"}},{"sys":{"id":"a2","linkType":"Entry","type":"Link"}}]},{"op":"add","path":"/fields/approver/en-US","value":{"sys":{"id":"a3","linkType":"Entry","type":"Link"}}},{"op":"remove","path":"/fields/body/de~1DE"}]`
+	// links are wrapped, locales are escaped as JSON Pointer segments
+	if string(b) != want {
+		t.Fatalf("got %s", b)
+	}
+}
+
+func TestPatchVersionMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		io.WriteString(w, `{"sys":{"type":"Error","id":"VersionMismatch"}}`)
+	}))
+	defer srv.Close()
+	e := &PostEntry{ID: "p1", Version: 1}
+	if err := newTestManagement(t, srv).Posts().Patch(e, PostPatch().SetSlug("en-US", "x")); err != ErrVersionMismatch {
+		t.Fatalf("got %v, want ErrVersionMismatch", err)
+	}
+}
+
+func TestEntryPatchCreatesMissingFields(t *testing.T) {
+	var got []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &got)
+		json.NewEncoder(w).Encode(map[string]interface{}{"sys": map[string]interface{}{"id": "p1", "version": 2, "contentType": map[string]interface{}{"sys": map[string]string{"id": postContentType}}}})
+	}))
+	defer srv.Close()
+	// the entry has tags in en-US only and no title at all
+	e := &PostEntry{ID: "p1", Version: 1, Fields: PostEntryFields{Tags: map[string][]string{"en-US": {"a"}}}}
+	p := PostPatch().SetTitle("en-US", "x").SetTitle("de-DE", "y").AddTag("de-DE", "go").AddTag("de-DE", "rust").AddTag("en-US", "b").AddCategory("en-US", "c1")
+	if err := newTestManagement(t, srv).Posts().Patch(e, p); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(got)
+	want := `[{"op":"add","path":"/fields/title","value":{"en-US":"x"}},{"op":"add","path":"/fields/title/de-DE","value":"y"},{"op":"add","path":"/fields/tags/de-DE","value":["go"]},{"op":"add","path":"/fields/tags/de-DE/-","value":"rust"},{"op":"add","path":"/fields/tags/en-US/-","value":"b"},{"op":"add","path":"/fields/category","value":{"en-US":[{"sys":{"id":"c1","linkType":"Entry","type":"Link"}}]}}]`
+	if string(b) != want {
+		t.Fatalf("got %s", b)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPostQueryEncoding(t *testing.T) {
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		query *PostQueryBuilder
+		want  string
+	}{
+		{"default order", PostQuery(), "order=sys.createdAt%2Csys.id"},
+		{"equals", PostQuery().SlugEquals("a b&c"), "fields.slug=a+b%26c&order=sys.createdAt%2Csys.id"},
+		{"in", PostQuery().TitleIn("x", "y"), "fields.title%5Bin%5D=x%2Cy&order=sys.createdAt%2Csys.id"},
+		{"range", PostQuery().DateGTE(date).DateLT(date.Add(time.Hour)), "fields.date%5Bgte%5D=2020-01-02T03%3A04%3A05Z&fields.date%5Blt%5D=2020-01-02T04%3A04%3A05Z&order=sys.createdAt%2Csys.id"},
+		{"exists", PostQuery().TitleExists(false), "fields.title%5Bexists%5D=false&order=sys.createdAt%2Csys.id"},
+		{"order", PostQuery().OrderByTitleDesc().OrderByCreatedAtAsc(), "order=-fields.title%2Csys.createdAt%2Csys.id"},
+		{"select", PostQuery().Select(PostFieldTitle, PostFieldSlug).Search("go"), "order=sys.createdAt%2Csys.id&query=go&select=sys%2Cfields.title%2Cfields.slug"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.query.encode(); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
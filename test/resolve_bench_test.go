@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func benchmarkEntry(id, contentType string, fields map[string]interface{}) includeEntry {
+	raw, err := json.Marshal(fields)
+	if err != nil {
+		panic(err)
+	}
+	msg := json.RawMessage(raw)
+	var entry = includeEntry{Fields: &msg}
+	entry.Sys.ID = id
+	entry.Sys.Type = "Entry"
+	entry.Sys.ContentType.Sys.ID = contentType
+	return entry
+}
+
+func benchmarkLink(linkType, id string) map[string]interface{} {
+	return map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": linkType, "id": id}}
+}
+
+// benchmarkResponse builds a page of posts linking to authors, assets and
+// category chains which are depth levels deep
+func benchmarkResponse(posts, depth int) *postResponse {
+	const shared = 100
+	data := &postResponse{Total: posts, Limit: posts}
+	for i := 0; i < posts; i++ {
+		k := i % shared
+		data.Items = append(data.Items, benchmarkEntry(fmt.Sprintf("post-%d", i), "2wKn6yEnZewu2SCCkus4as", map[string]interface{}{
+			"title":         fmt.Sprintf("Post %d", i),
+			"slug":          fmt.Sprintf("post-%d", i),
+			"author":        []interface{}{benchmarkLink("Entry", fmt.Sprintf("author-%d", k))},
+			"category":      []interface{}{benchmarkLink("Entry", fmt.Sprintf("category-%d-0", k))},
+			"featuredImage": benchmarkLink("Asset", fmt.Sprintf("asset-%d", k)),
+			"approver":      benchmarkLink("Entry", fmt.Sprintf("author-%d", k)),
+			"authorOrPost": []interface{}{
+				benchmarkLink("Entry", fmt.Sprintf("author-%d", k)),
+				benchmarkLink("Entry", fmt.Sprintf("post-%d", (i+1)%posts)),
+			},
+		}))
+	}
+	for k := 0; k < shared; k++ {
+		var created []interface{}
+		for i := k; i < posts; i += shared * 10 {
+			created = append(created, benchmarkLink("Entry", fmt.Sprintf("post-%d", i)))
+		}
+		data.Includes.Entries = append(data.Includes.Entries, benchmarkEntry(fmt.Sprintf("author-%d", k), "1kUEViTN4EmGiEaaeC6ouY", map[string]interface{}{
+			"name":           fmt.Sprintf("Author %d", k),
+			"profilePhoto":   benchmarkLink("Asset", fmt.Sprintf("asset-%d", k)),
+			"createdEntries": created,
+		}))
+		for d := 0; d < depth; d++ {
+			fields := map[string]interface{}{
+				"title": fmt.Sprintf("Category %d-%d", k, d),
+				"icon":  benchmarkLink("Asset", fmt.Sprintf("asset-%d", k)),
+			}
+			if d+1 < depth {
+				fields["parent"] = benchmarkLink("Entry", fmt.Sprintf("category-%d-%d", k, d+1))
+			}
+			data.Includes.Entries = append(data.Includes.Entries, benchmarkEntry(fmt.Sprintf("category-%d-%d", k, d), "5KMiN6YPvi42icqAUQMCQe", fields))
+		}
+		var asset includeAsset
+		asset.Sys.ID = fmt.Sprintf("asset-%d", k)
+		asset.Fields.File.URL = fmt.Sprintf("//images.example.com/%d.png", k)
+		data.Includes.Assets = append(data.Includes.Assets, asset)
+	}
+	return data
+}
+
+func BenchmarkDecodePosts(b *testing.B) {
+	data := benchmarkResponse(1000, 10)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := (&ContentClient{}).decodePosts(data, newIteratorCache()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
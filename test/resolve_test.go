@@ -0,0 +1,90 @@
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestMultiTypeLinksKeepOrderAndDuplicates(t *testing.T) {
+	post := benchmarkEntry("p0", postContentType, map[string]interface{}{
+		"title": "x",
+		"authorOrPost": []interface{}{
+			benchmarkLink("Entry", "a2"),
+			benchmarkLink("Entry", "p1"),
+			benchmarkLink("Entry", "a2"),
+		},
+	})
+	data := &postResponse{Items: []includeEntry{post}}
+	data.Includes.Entries = []includeEntry{
+		benchmarkEntry("p1", postContentType, map[string]interface{}{"title": "p1"}),
+		benchmarkEntry("a2", "1kUEViTN4EmGiEaaeC6ouY", map[string]interface{}{"name": "a2"}),
+	}
+	posts, err := (&ContentClient{}).decodePosts(data, newIteratorCache())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, v := range posts[0].AuthorOrPost {
+		if !v.Resolved {
+			t.Fatalf("link to %s is unresolved", v.ID)
+		}
+		switch x := v.Value.(type) {
+		case Author:
+			got = append(got, "Author:"+x.ID)
+		case Post:
+			got = append(got, "Post:"+x.ID)
+		default:
+			t.Fatalf("unexpected %T", v.Value)
+		}
+	}
+	if len(got) != 3 || got[0] != "Author:a2" || got[1] != "Post:p1" || got[2] != "Author:a2" {
+		t.Fatalf("got %v", got)
+	}
+}
+
+func TestUnresolvedLinks(t *testing.T) {
+	post := benchmarkEntry("p0", postContentType, map[string]interface{}{
+		"title":         "x",
+		"approver":      benchmarkLink("Entry", "gone"),
+		"featuredImage": benchmarkLink("Asset", "img"),
+	})
+	data := &postResponse{Items: []includeEntry{post}}
+	if err := json.Unmarshal([]byte(`[{"sys":{"id":"notResolvable","type":"error"},"details":{"type":"Link","linkType":"Entry","id":"gone"}}]`), &data.Errors); err != nil {
+		t.Fatal(err)
+	}
+	posts, err := (&ContentClient{}).decodePosts(data, newIteratorCache())
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := posts[0]
+	if p.Approver.ID != "gone" || p.Approver.Resolved {
+		t.Fatalf("approver %+v", p.Approver)
+	}
+	if p.FeaturedImage.ID != "img" || p.FeaturedImage.Resolved {
+		t.Fatalf("featured image %+v", p.FeaturedImage)
+	}
+	if p.Title != "x" {
+		t.Fatalf("title %q", p.Title)
+	}
+	if got := data.Errors[0].Error(); got != "notResolvable: Entry gone" {
+		t.Fatalf("include error %q", got)
+	}
+}
+
+// authorOrPostNames records the visited items of Post.AuthorOrPost
+type authorOrPostNames []string
+
+func (n *authorOrPostNames) VisitAuthor(a Author) { *n = append(*n, "author "+a.Name) }
+func (n *authorOrPostNames) VisitPost(p Post)     { *n = append(*n, "post "+p.Title) }
+
+func TestVisitMultiTypeLinks(t *testing.T) {
+	var names authorOrPostNames
+	items := []PostAuthorOrPostItem{Author{Name: "ann"}, Post{Title: "hello"}, nil}
+	for _, item := range items {
+		VisitPostAuthorOrPostItem(item, &names)
+	}
+	if got := strings.Join(names, ","); got != "author ann,post hello" {
+		t.Fatalf("visited %s", got)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// versionServer serves a single resource at version, answering the next conflicts updates
+// with a version mismatch as if another client updated the resource in between
+func versionServer(version, conflicts *int, puts *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			*puts++
+			if v, _ := strconv.Atoi(r.Header.Get("X-Contentful-Version")); v != *version || *conflicts > 0 {
+				*conflicts--
+				*version++
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"sys":{"type":"Error","id":"VersionMismatch"}}`))
+				return
+			}
+			*version++
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"sys":    map[string]interface{}{"id": "x", "version": *version, "contentType": map[string]interface{}{"sys": map[string]string{"id": postContentType}}},
+			"fields": map[string]interface{}{"title": map[string]string{"en-US": "t"}},
+			"name":   "hook",
+		})
+	}))
+}
+
+func TestEntryUpdateWithRetry(t *testing.T) {
+	version, conflicts, puts := 3, 2, 0
+	srv := versionServer(&version, &conflicts, &puts)
+	defer srv.Close()
+	m := newTestManagement(t, srv)
+
+	calls := 0
+	e, err := m.Posts().UpdateWithRetry("x", func(e *PostEntry) error {
+		calls++
+		e.Fields.Title["de-DE"] = "T"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 || puts != 3 || e.Version != version {
+		t.Fatalf("calls=%d puts=%d version=%d, server at %d", calls, puts, e.Version, version)
+	}
+}
+
+func TestWebhookUpdateWithRetry(t *testing.T) {
+	version, conflicts, puts := 3, 1, 0
+	srv := versionServer(&version, &conflicts, &puts)
+	defer srv.Close()
+	m := newTestManagement(t, srv)
+
+	w, err := m.Webhooks().UpdateWithRetry("x", func(w *Webhook) error {
+		w.Name = "renamed"
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.ID != "x" || w.Version != version || puts != 2 {
+		t.Fatalf("id=%q version=%d puts=%d, server at %d", w.ID, w.Version, puts, version)
+	}
+	// the returned version is current, a following update succeeds without a conflict
+	if err := m.Webhooks().Update(w); err != nil {
+		t.Fatal(err)
+	}
+	if w.Version != version || puts != 3 {
+		t.Fatalf("version=%d puts=%d, server at %d", w.Version, puts, version)
+	}
+}
+
+func TestUpdateWithRetryGivesUp(t *testing.T) {
+	version, conflicts, puts := 3, 100, 0
+	srv := versionServer(&version, &conflicts, &puts)
+	defer srv.Close()
+	m := newTestManagement(t, srv)
+
+	if _, err := m.Webhooks().UpdateWithRetry("x", func(w *Webhook) error { return nil }); !errors.Is(err, ErrVersionMismatch) {
+		t.Fatalf("expected a version mismatch, got %v", err)
+	}
+	if puts != 5 {
+		t.Fatalf("expected 5 attempts, got %d", puts)
+	}
+	stop := errors.New("stop")
+	if _, err := m.Webhooks().UpdateWithRetry("x", func(w *Webhook) error { return stop }); err != stop {
+		t.Fatalf("expected the error of fn, got %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// storeServer answers the initial sync with items and every later sync with deleted
+func storeServer(t *testing.T, items []interface{}, deleted ...string) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/locales") {
+			w.Write([]byte(testLocales))
+			return
+		}
+		q := r.URL.Query()
+		page := items
+		if q.Get("initial") != "true" {
+			page = nil
+			for _, id := range deleted {
+				page = append(page, map[string]interface{}{"sys": map[string]string{"id": id, "type": "DeletedEntry"}})
+			}
+		}
+		json.NewEncoder(w).Encode(syncPage(page, "", "https://cdn.contentful.com/spaces/x/sync?sync_token=t"+q.Get("sync_token")))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func slugPost(id, slug string) map[string]interface{} {
+	return testEntry(id, postContentType, map[string]interface{}{"slug": map[string]string{"en-US": slug}})
+}
+
+func TestStoreResolvesLinksBeyondIncludeDepth(t *testing.T) {
+	var items []interface{}
+	// a chain of categories deeper than the include limit of the delivery api
+	for i := 0; i < 15; i++ {
+		fields := map[string]interface{}{"title": map[string]string{"en-US": "c"}}
+		if i < 14 {
+			fields["parent"] = map[string]interface{}{"en-US": syncLink("c" + string(rune('a'+i+1)))}
+		}
+		items = append(items, testEntry("c"+string(rune('a'+i)), "5KMiN6YPvi42icqAUQMCQe", fields))
+	}
+	post := slugPost("p0", "hello")
+	post["fields"].(map[string]interface{})["category"] = map[string]interface{}{"en-US": []interface{}{syncLink("ca")}}
+	items = append(items, post)
+	c := newTestCDA(t, storeServer(t, items, "co"))
+
+	s := c.NewStore()
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	p, err := s.PostBySlug("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	depth := 0
+	for cat := p.Category[0]; cat.Resolved && cat.Value.Parent.Value != nil; depth++ {
+		cat = Ref[Category]{ID: cat.Value.Parent.ID, Resolved: cat.Value.Parent.Resolved, Value: *cat.Value.Parent.Value}
+	}
+	if depth != 14 {
+		t.Fatalf("resolved %d parents, want 14", depth)
+	}
+
+	path := filepath.Join(t.TempDir(), "store.json")
+	if err := s.Snapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	r := c.NewStore()
+	if err := r.Restore(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.PostBySlug("hello"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Categories()) != 14 || r.token != "tt" {
+		t.Fatalf("got %d categories and token %s after resuming", len(r.Categories()), r.token)
+	}
+}
+
+func TestStoreUniqueLookup(t *testing.T) {
+	c := newTestCDA(t, storeServer(t, []interface{}{
+		slugPost("p0", "hello"),
+		slugPost("p1", "twice"),
+		slugPost("p2", "twice"),
+	}))
+	s := c.NewStore()
+	if err := s.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		slug string
+		id   string
+		err  error
+	}{
+		{"hello", "p0", nil},
+		{"missing", "", &NotFoundError{ID: "missing", Field: "slug", ContentType: postContentType}},
+		{"twice", "", &DuplicateError{Type: "Post", Field: "Slug", Value: "twice"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.slug, func(t *testing.T) {
+			p, err := s.PostBySlug(tt.slug)
+			if tt.err != nil {
+				if err == nil || err.Error() != tt.err.Error() {
+					t.Fatalf("got %v, want %v", err, tt.err)
+				}
+				return
+			}
+			if err != nil || p.ID != tt.id {
+				t.Fatalf("got %v, %v", p, err)
+			}
+		})
+	}
+}
+
+func TestStoreKeepsTokenWhenIndexingFails(t *testing.T) {
+	c := newTestCDA(t, storeServer(t, []interface{}{
+		testEntry("p0", postContentType, map[string]interface{}{"slug": map[string]int{"en-US": 1}}),
+	}))
+	s := c.NewStore()
+	if err := s.Sync(); err == nil {
+		t.Fatal("expected an error indexing a malformed slug")
+	}
+	// the next sync starts over instead of skipping the entries that failed
+	if s.token != "" {
+		t.Fatalf("token advanced to %q", s.token)
+	}
+
+	good := newTestCDA(t, storeServer(t, []interface{}{slugPost("p0", "hello")})).NewStore()
+	if err := good.Sync(); err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "store.json")
+	if err := s.Snapshot(path); err != nil {
+		t.Fatal(err)
+	}
+	if err := good.Restore(path); err == nil {
+		t.Fatal("expected an error restoring a malformed slug")
+	}
+	if _, err := good.PostBySlug("hello"); err != nil || good.token != "t" {
+		t.Fatalf("store changed by a failed restore: %v, token %q", err, good.token)
+	}
+}
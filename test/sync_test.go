@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+const testLocales = `{"items":[{"code":"en-US","default":true},{"code":"de"}]}`
+
+func syncLink(id string) map[string]interface{} {
+	return map[string]interface{}{"sys": map[string]string{"type": "Link", "linkType": "Entry", "id": id}}
+}
+
+func syncPage(items []interface{}, nextPage, nextSync string) map[string]interface{} {
+	return map[string]interface{}{"items": items, "nextPageUrl": nextPage, "nextSyncUrl": nextSync}
+}
+
+func syncServer(t *testing.T, requests *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/locales") {
+			*requests = append(*requests, "locales")
+			w.Write([]byte(testLocales))
+			return
+		}
+		q := r.URL.Query()
+		*requests = append(*requests, q.Get("initial")+q.Get("sync_token"))
+		switch {
+		case q.Get("initial") == "true":
+			json.NewEncoder(w).Encode(syncPage([]interface{}{
+				testEntry("p0", postContentType, map[string]interface{}{
+					"title":    map[string]string{"en-US": "hello", "de": "hallo"},
+					"slug":     map[string]string{"en-US": "hello"},
+					"approver": map[string]interface{}{"en-US": syncLink("a1")},
+				}),
+			}, "https://cdn.contentful.com/spaces/x/sync?sync_token=page2", ""))
+		case q.Get("sync_token") == "page2":
+			json.NewEncoder(w).Encode(syncPage([]interface{}{
+				testEntry("a1", "1kUEViTN4EmGiEaaeC6ouY", map[string]interface{}{"name": map[string]string{"en-US": "ann"}}),
+				map[string]interface{}{"sys": map[string]string{"id": "gone", "type": "DeletedEntry"}},
+				map[string]interface{}{"sys": map[string]string{"id": "img", "type": "Asset"}, "fields": map[string]interface{}{"title": map[string]string{"en-US": "pic"}, "file": map[string]interface{}{"en-US": map[string]string{"url": "//x/y.png"}}}},
+			}, "", "https://cdn.contentful.com/spaces/x/sync?sync_token=next"))
+		case q.Get("sync_token") == "next":
+			json.NewEncoder(w).Encode(syncPage([]interface{}{
+				map[string]interface{}{"sys": map[string]string{"id": "p0", "type": "DeletedEntry"}},
+			}, "", "https://cdn.contentful.com/spaces/x/sync?sync_token=last"))
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+}
+
+func TestSyncFollowsPagesAndResumesFromToken(t *testing.T) {
+	var requests []string
+	srv := syncServer(t, &requests)
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+
+	res, err := c.Sync("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token != "next" || len(res.Posts) != 1 || len(res.Authors) != 1 || len(res.DeletedEntries) != 1 || len(res.Assets) != 1 {
+		t.Fatalf("unexpected result %+v", res)
+	}
+	p := res.Posts[0]
+	if p.Title != "hello" || !p.Approver.Resolved || p.Approver.Value.Name != "ann" || res.Assets[0].URL != "https://x/y.png" {
+		t.Fatalf("unexpected post %+v", p)
+	}
+
+	res, err = c.Sync(res.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Token != "last" || len(res.Posts) != 0 || len(res.DeletedEntries) != 1 || res.DeletedEntries[0] != "p0" {
+		t.Fatalf("unexpected resumed result %+v", res)
+	}
+	if got := strings.Join(requests, ","); got != "locales,true,page2,next" {
+		t.Fatalf("unexpected requests %s", got)
+	}
+}
+
+func TestSyncInNonDefaultLocaleKeepsUnlocalizedFields(t *testing.T) {
+	var requests []string
+	srv := syncServer(t, &requests)
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.Locale = "de"
+
+	res, err := c.Sync("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := res.Posts[0]
+	if p.Title != "hallo" || p.Slug != "hello" {
+		t.Fatalf("expected the localized title and the default locale slug, got title=%q slug=%q", p.Title, p.Slug)
+	}
+	if !p.Approver.Resolved || p.Approver.Value.Name != "ann" {
+		t.Fatalf("expected the default locale link to resolve, got %+v", p.Approver)
+	}
+}
+
+// closeRecorder records whether the bodies of the responses it passes on were closed
+type closeRecorder struct {
+	open int32
+}
+
+func (r *closeRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err == nil {
+		atomic.AddInt32(&r.open, 1)
+		resp.Body = recordedBody{resp.Body, r}
+	}
+	return resp, err
+}
+
+type recordedBody struct {
+	io.ReadCloser
+	r *closeRecorder
+}
+
+func (b recordedBody) Close() error {
+	atomic.AddInt32(&b.r.open, -1)
+	return b.ReadCloser.Close()
+}
+
+func TestSyncClosesUndecodableResponses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/locales") {
+			w.Write([]byte(testLocales))
+			return
+		}
+		w.Write([]byte(`{"items":`))
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	recorder := &closeRecorder{}
+	c.client = &http.Client{Transport: recorder}
+	if _, err := c.Sync(""); err == nil {
+		t.Fatal("expected a decoding error")
+	}
+	if recorder.open != 0 {
+		t.Fatalf("%d response bodies left open", recorder.open)
+	}
+}
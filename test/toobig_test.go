@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestResponseTooBigShrinksPages(t *testing.T) {
+	s := &postServer{n: 40}
+	var mu sync.Mutex
+	var limits []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		mu.Lock()
+		limits = append(limits, limit)
+		mu.Unlock()
+		// the first 20 entries are too large to be returned more than 8 at a time
+		if limit > 8 && skip < 20 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"sys":{"id":"BadRequest"},"message":"Response size too big. Maximum allowed response size: 7340032B."}`)
+			return
+		}
+		s.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.SetRateLimit(0)
+
+	items, err := c.Posts(ListOptions{Limit: 32}).Collect(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(items) != 40 {
+		t.Fatalf("got %d items", len(items))
+	}
+	for i, p := range items {
+		if p.ID != fmt.Sprintf("p%d", i) {
+			t.Fatalf("item %d is %s", i, p.ID)
+		}
+	}
+	// the page size halves on every failure and doubles again after each successful page
+	if got := fmt.Sprint(limits); got != "[32 16 8 16 8 16 8 16]" {
+		t.Fatalf("requested limits %s", got)
+	}
+}
+
+func TestResponseTooBigForSingleEntry(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"sys":{"id":"BadRequest"},"message":"Response size too big. Maximum allowed response size: 7340032B."}`)
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+	c.SetRateLimit(0)
+	if _, err := c.Posts(ListOptions{Limit: 4}).Collect(0); !errors.Is(err, ErrResponseTooBig) {
+		t.Fatalf("got %v, want ErrResponseTooBig", err)
+	}
+}
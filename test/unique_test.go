@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUniqueLookup(t *testing.T) {
+	slugs := map[string]string{"p0": "hello", "p1": "twice", "p2": "twice"}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("limit") != "2" {
+			t.Errorf("limit %s, want 2 to detect duplicates", q.Get("limit"))
+		}
+		items := []interface{}{}
+		for _, id := range []string{"p0", "p1", "p2"} {
+			if slugs[id] == q.Get("fields.slug") {
+				items = append(items, map[string]interface{}{
+					"sys":    map[string]interface{}{"id": id, "type": "Entry", "contentType": map[string]interface{}{"sys": map[string]interface{}{"id": postContentType}}},
+					"fields": map[string]interface{}{"slug": slugs[id]},
+				})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"total": len(items), "items": items, "includes": map[string]interface{}{}})
+	}))
+	defer srv.Close()
+	c := newTestCDA(t, srv)
+
+	p, err := c.PostBySlug("hello")
+	if err != nil || p.ID != "p0" {
+		t.Fatalf("got %+v, %v", p, err)
+	}
+	var notFound *NotFoundError
+	if _, err := c.PostBySlug("missing"); !errors.As(err, &notFound) || notFound.Field != "slug" {
+		t.Fatalf("got %v, want NotFoundError", err)
+	}
+	var duplicate *DuplicateError
+	if _, err := c.PostBySlug("twice"); !errors.As(err, &duplicate) || err.Error() != `Post.Slug "twice" is not unique` {
+		t.Fatalf("got %v, want DuplicateError", err)
+	}
+}
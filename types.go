@@ -19,6 +19,47 @@ func generateResponseTypes(f *jen.File) {
 		).Tag(map[string]string{"json": "contentType"}),
 	)
 
+	f.Comment("Ref links to an entry or asset. Resolved is false when the target was not part of the response, e.g. because it is unpublished, deleted or beyond the include depth")
+	f.Type().Id("Ref").Types(jen.Id("T").Any()).Struct(
+		jen.Id("ID").String(),
+		jen.Id("Resolved").Bool(),
+		jen.Id("Value").Id("T"),
+	)
+
+	f.Comment("IncludeError describes a link contentful reported in the errors of a response, e.g. as notResolvable")
+	f.Type().Id("IncludeError").Struct(
+		jen.Id("Sys").Struct(
+			jen.Id("ID").String().Tag(map[string]string{"json": "id"}),
+			jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+		).Tag(map[string]string{"json": "sys"}),
+		jen.Id("Details").Struct(
+			jen.Id("Type").String().Tag(map[string]string{"json": "type"}),
+			jen.Id("LinkType").String().Tag(map[string]string{"json": "linkType"}),
+			jen.Id("ID").String().Tag(map[string]string{"json": "id"}),
+		).Tag(map[string]string{"json": "details"}),
+	)
+
+	f.Func().Params(
+		jen.Id("e").Id("IncludeError"),
+	).Id("Error").Params().String().Block(
+		jen.Return(jen.Qual("fmt", "Sprintf").Call(
+			jen.Lit("%s: %s %s"),
+			jen.Id("e.Sys.ID"),
+			jen.Id("e.Details.LinkType"),
+			jen.Id("e.Details.ID"),
+		)),
+	)
+
+	f.Comment("entriesResponse holds an entire contentful response of mixed content types")
+	f.Type().Id("entriesResponse").Struct(
+		jen.Id("Total").Int().Tag(map[string]string{"json": "total"}),
+		jen.Id("Skip").Int().Tag(map[string]string{"json": "skip"}),
+		jen.Id("Limit").Int().Tag(map[string]string{"json": "limit"}),
+		jen.Id("Items").Index().Id("includeEntry").Tag(map[string]string{"json": "items"}),
+		jen.Id("Includes").Id("includes").Tag(map[string]string{"json": "includes"}),
+		jen.Id("Errors").Index().Id("IncludeError").Tag(map[string]string{"json": "errors"}),
+	)
+
 	f.Type().Id("entryID").Struct(
 		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
 	)
@@ -32,9 +73,14 @@ func generateResponseTypes(f *jen.File) {
 	f.Type().Id("includeAsset").Struct(
 		jen.Id("Sys").Id("sys").Tag(map[string]string{"json": "sys"}),
 		jen.Id("Fields").Struct(
+			jen.Id("Title").String().Tag(map[string]string{"json": "title"}),
+			jen.Id("Description").String().Tag(map[string]string{"json": "description"}),
 			jen.Id("File").Struct(
 				jen.Id("URL").String().Tag(map[string]string{"json": "url"}),
+				jen.Id("FileName").String().Tag(map[string]string{"json": "fileName"}),
+				jen.Id("ContentType").String().Tag(map[string]string{"json": "contentType"}),
 				jen.Id("Details").Struct(
+					jen.Id("Size").Int64().Tag(map[string]string{"json": "size"}),
 					jen.Id("Image").Struct(
 						jen.Id("Width").Int64().Tag(map[string]string{"json": "width"}),
 						jen.Id("Height").Int64().Tag(map[string]string{"json": "height"}),
@@ -67,16 +113,28 @@ func generateDateType(f *jen.File) {
 		jen.Op("*").Id("d").Op("=").Id("Date").Call(jen.Id("t")),
 		jen.Return(jen.Err()),
 	)
+
+	f.Comment("MarshalJSON serializes a date as iso 8601 short date string")
+	f.Func().Params(
+		jen.Id("d").Id("Date"),
+	).Id("MarshalJSON").Params().Params(
+		jen.Index().Byte(), jen.Error(),
+	).Block(
+		jen.Return(jen.Qual("encoding/json", "Marshal").Call(jen.Qual("time", "Time").Call(jen.Id("d")).Dot("Format").Call(jen.Id("dateLayout")))),
+	)
 }
 
 func generateAssetType(f *jen.File) {
 	f.Comment("Asset defines a media item in contentful")
 	f.Type().Id("Asset").Struct(
+		jen.Id("ID").String(),
 		jen.Id("Title").String(),
 		jen.Id("Description").String(),
 		jen.Id("URL").String(),
 		jen.Id("Width").Int64(),
 		jen.Id("Height").Int64(),
 		jen.Id("Size").Int64(),
+		jen.Id("FileName").String(),
+		jen.Id("ContentType").String(),
 	)
 }